@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/diagnosis"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDcsV2BackupLifeCycle(t *testing.T) {
+	client, err := clients.NewDcsV2Client()
+	th.AssertNoErr(t, err)
+
+	dcsInstance := createDCSInstance(t, client)
+	defer deleteDCSInstance(t, client, dcsInstance.InstanceID)
+
+	t.Logf("Attempting to backup DCSv2 instance")
+	backupId, err := backups.Create(client, backups.CreateOpts{
+		InstanceId:   dcsInstance.InstanceID,
+		Remark:       "acceptance",
+		BackupFormat: "rdb",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, backups.WaitForBackup(client, dcsInstance.InstanceID, backupId, 600))
+
+	t.Logf("Attempting to restore DCSv2 backup %s", backupId)
+	restoreId, err := backups.Restore(client, backups.RestoreOpts{InstanceId: dcsInstance.InstanceID, BackupId: backupId})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, backups.WaitForRestore(client, dcsInstance.InstanceID, restoreId, 600))
+	th.AssertNoErr(t, waitForInstanceAvailable(client, 600, dcsInstance.InstanceID))
+
+	th.AssertNoErr(t, backups.Delete(client, dcsInstance.InstanceID, backupId))
+}
+
+func TestDcsV2Diagnosis(t *testing.T) {
+	client, err := clients.NewDcsV2Client()
+	th.AssertNoErr(t, err)
+
+	dcsInstance := createDCSInstance(t, client)
+	defer deleteDCSInstance(t, client, dcsInstance.InstanceID)
+
+	task, err := diagnosis.CreateBigKeyScan(client, dcsInstance.InstanceID)
+	th.AssertNoErr(t, err)
+	bigKeys, err := diagnosis.WaitForBigKeyScan(client, dcsInstance.InstanceID, task.Id, 300)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, bigKeys.Num)
+	th.AssertNoErr(t, diagnosis.DeleteBigKeyScan(client, dcsInstance.InstanceID, task.Id))
+
+	expired, err := diagnosis.CreateExpiredKeyScan(client, dcsInstance.InstanceID)
+	th.AssertNoErr(t, err)
+	_, err = diagnosis.WaitForExpiredKeyScan(client, dcsInstance.InstanceID, expired.Id, 300)
+	th.AssertNoErr(t, err)
+
+	now := time.Now().UTC()
+	_, err = diagnosis.ListSlowLogs(client, dcsInstance.InstanceID, diagnosis.ListSlowLogsOpts{
+		StartTime: now.Add(-time.Hour).Format("2006-01-02T15:04:05.000Z"),
+		EndTime:   now.Format("2006-01-02T15:04:05.000Z"),
+	})
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,80 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/job"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDdsBackupLifeCycle(t *testing.T) {
+	client, err := clients.NewDdsV3Client()
+	th.AssertNoErr(t, err)
+
+	ddsInstance := createDdsSingleInstance(t, client)
+	defer deleteDdsInstance(t, client, ddsInstance.Id)
+
+	policy := backups.BackupPolicy{KeepDays: 7, StartTime: "23:00-00:00", Period: "1,2,3,4,5,6,7"}
+	err = backups.SetPolicy(client, backups.SetPolicyOpts{InstanceId: ddsInstance.Id, BackupPolicy: policy})
+	th.AssertNoErr(t, err)
+	storedPolicy, err := backups.GetPolicy(client, ddsInstance.Id)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, policy.KeepDays, storedPolicy.KeepDays)
+
+	t.Logf("Attempting to create DDSv3 backup")
+	backup, err := backups.Create(client, backups.CreateOpts{
+		InstanceId: ddsInstance.Id,
+		Name:       tools.RandomString("dds-backup-", 3),
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, backups.WaitForBackup(client, 1200, backup.BackupId))
+
+	list, err := backups.List(client, backups.ListBackupsOpts{InstanceId: ddsInstance.Id, BackupType: "Manual"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+
+	t.Logf("Attempting to restore DDSv3 backup %s", backup.BackupId)
+	jobId, err := backups.Restore(client, backups.RestoreOpts{
+		Source: backups.Source{InstanceId: ddsInstance.Id, Type: "backup", BackupId: backup.BackupId},
+		Target: backups.Target{InstanceId: ddsInstance.Id},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, job.WaitForJobCompleted(client, 1200, *jobId))
+
+	jobId, err = backups.Delete(client, backup.BackupId)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, job.WaitForJobCompleted(client, 600, *jobId))
+}
+
+func TestDdsConfigurationLifeCycle(t *testing.T) {
+	client, err := clients.NewDdsV3Client()
+	th.AssertNoErr(t, err)
+
+	config, err := configurations.Create(client, configurations.CreateOpts{
+		Name:            tools.RandomString("dds-config-", 3),
+		ParameterValues: map[string]string{"net.maxIncomingConnections": "1000"},
+		Datastore:       configurations.Datastore{Type: "DDS-Community", Version: "4.0", NodeType: "single"},
+	})
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, configurations.Delete(client, config.Id))
+	}()
+
+	err = configurations.Update(client, configurations.UpdateOpts{
+		ConfigId:        config.Id,
+		ParameterValues: map[string]string{"net.maxIncomingConnections": "2000"},
+	})
+	th.AssertNoErr(t, err)
+
+	details, err := configurations.Get(client, config.Id)
+	th.AssertNoErr(t, err)
+	for _, p := range details.Parameters {
+		if p.Name == "net.maxIncomingConnections" {
+			th.AssertEquals(t, "2000", p.Value)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/messages"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestGroupsAndMessages(t *testing.T) {
+	t.Skip("DMS Creation takes too long to complete")
+	client, err := clients.NewDmsV2Client()
+	th.AssertNoErr(t, err)
+
+	instanceID := createDmsInstance(t, client)
+	defer deleteDmsInstance(t, client, instanceID)
+
+	dmsTopic := createTopic(t, client, instanceID)
+	defer deleteTopic(t, client, instanceID, dmsTopic)
+
+	dmsGroups, err := groups.List(client, instanceID, groups.ListOpts{})
+	th.AssertNoErr(t, err)
+	for _, val := range dmsGroups.Groups {
+		tools.PrintResource(t, val)
+	}
+
+	now := time.Now()
+	dmsMessages, err := messages.List(client, instanceID, messages.ListOpts{
+		Topic:     dmsTopic,
+		StartTime: now.Add(-time.Hour).UnixMilli(),
+		EndTime:   now.UnixMilli(),
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, dmsMessages.Total)
+
+	end, err := messages.GetEndOffset(client, instanceID, dmsTopic, 0)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(0), end.MessageOffset)
+}
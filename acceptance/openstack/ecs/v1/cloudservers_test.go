@@ -7,6 +7,7 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ecs/v1/cloudservers"
 	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
 )
 
@@ -38,5 +39,40 @@ func TestCloudServerLifecycle(t *testing.T) {
 	err = tags.Create(client, "cloudservers", ecs.ID, tagsList).ExtractErr()
 	th.AssertNoErr(t, err)
 
+	pages, err := cloudservers.List(client, cloudservers.ListOpts{
+		Name: ecs.Name,
+		Tags: []string{"TestKey=TestValue"},
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	listed, err := cloudservers.ExtractServers(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(listed))
+	th.AssertEquals(t, ecs.ID, listed[0].ID)
+
+	servers := []cloudservers.Server{{Id: ecs.ID}}
+
+	t.Logf("Attempting to stop ECSv1: %s", ecs.ID)
+	job, err := cloudservers.BatchStop(client, cloudservers.BatchStopOpts{
+		Servers: servers,
+		Type:    cloudservers.Soft,
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudservers.WaitForJobSuccess(client, 600, job.JobID))
+
+	t.Logf("Attempting to start ECSv1: %s", ecs.ID)
+	job, err = cloudservers.BatchStart(client, cloudservers.BatchStartOpts{
+		Servers: servers,
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudservers.WaitForJobSuccess(client, 600, job.JobID))
+
+	t.Logf("Attempting to reboot ECSv1: %s", ecs.ID)
+	job, err = cloudservers.BatchReboot(client, cloudservers.BatchRebootOpts{
+		Servers: servers,
+		Type:    cloudservers.Soft,
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudservers.WaitForJobSuccess(client, 600, job.JobID))
+
 	tools.PrintResource(t, ecs)
 }
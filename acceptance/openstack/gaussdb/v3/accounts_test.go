@@ -0,0 +1,91 @@
+package v3
+
+import (
+	"os"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	v3 "github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/accounts"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/configurations"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/proxy"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestGaussDBAccounts(t *testing.T) {
+	instanceId := os.Getenv("GAUSSDB_INSTANCE_ID")
+	if instanceId == "" {
+		t.Skip("GAUSSDB_INSTANCE_ID is required for this test")
+	}
+	client, err := clients.NewGaussDBClient()
+	th.AssertNoErr(t, err)
+
+	dbName := tools.RandomString("db_", 5)
+	jobId, err := accounts.CreateDatabase(client, accounts.CreateDatabaseOpts{
+		InstanceId: instanceId,
+		Databases:  []accounts.Database{{Name: dbName, CharacterSet: "utf8mb4"}},
+	})
+	th.AssertNoErr(t, err)
+	_, err = v3.WaitForGaussJob(client, *jobId, 600)
+	th.AssertNoErr(t, err)
+
+	t.Cleanup(func() {
+		jobId, err := accounts.DeleteDatabase(client, accounts.DeleteDatabaseOpts{
+			InstanceId:    instanceId,
+			DatabaseNames: []string{dbName},
+		})
+		th.AssertNoErr(t, err)
+		_, err = v3.WaitForGaussJob(client, *jobId, 600)
+		th.AssertNoErr(t, err)
+	})
+
+	userName := tools.RandomString("user_", 5)
+	jobId, err = accounts.CreateUser(client, accounts.CreateUserOpts{
+		InstanceId: instanceId,
+		Users: []accounts.User{{
+			Name:      userName,
+			Password:  "gaussdb1!-test",
+			Databases: []accounts.UserDatabase{{Name: dbName}},
+		}},
+	})
+	th.AssertNoErr(t, err)
+	_, err = v3.WaitForGaussJob(client, *jobId, 600)
+	th.AssertNoErr(t, err)
+
+	t.Cleanup(func() {
+		jobId, err := accounts.DeleteUser(client, accounts.DeleteUserOpts{
+			InstanceId: instanceId,
+			Users:      []accounts.UserHost{{Name: userName, Host: "%"}},
+		})
+		th.AssertNoErr(t, err)
+		_, err = v3.WaitForGaussJob(client, *jobId, 600)
+		th.AssertNoErr(t, err)
+	})
+
+	users, err := accounts.ListUsers(client, accounts.ListUsersOpts{InstanceId: instanceId})
+	th.AssertNoErr(t, err)
+	found := false
+	for _, user := range users.Users {
+		if user.Name == userName {
+			found = true
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	jobId, err = accounts.ResetUserPassword(client, accounts.ResetUserPasswordOpts{
+		InstanceId: instanceId,
+		Users:      []accounts.UserPassword{{Name: userName, Host: "%", Password: "gaussdb2!-test"}},
+	})
+	th.AssertNoErr(t, err)
+	_, err = v3.WaitForGaussJob(client, *jobId, 600)
+	th.AssertNoErr(t, err)
+
+	params, err := configurations.GetInstanceConfiguration(client, configurations.GetInstanceConfigurationOpts{InstanceId: instanceId})
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, params.Configurations)
+
+	flavors, err := proxy.ListProxyFlavors(client, instanceId)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, flavors)
+}
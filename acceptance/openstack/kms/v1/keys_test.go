@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
@@ -98,3 +99,27 @@ func TestKmsEncryptDataLifecycle(t *testing.T) {
 
 	tools.PrintResource(t, kmsDecrypt)
 }
+
+func TestKmsDataKeyLifecycle(t *testing.T) {
+	kmsID := clients.EnvOS.GetEnv("KMS_ID")
+	if kmsID == "" {
+		t.Skip("OS_KMS_ID env var is missing but KMSv1 data key test requires")
+	}
+
+	client, err := clients.NewKMSV1Client()
+	th.AssertNoErr(t, err)
+
+	dataKey, err := keys.DataEncryptGet(client, keys.DataEncryptOpts{
+		KeyID:         kmsID,
+		DatakeyLength: "256",
+	}).ExtractDataKey()
+	th.AssertNoErr(t, err)
+
+	decrypted, err := keys.DecryptDEK(client, keys.DecryptDEKOpts{
+		KeyID:               kmsID,
+		CipherText:          dataKey.CipherText,
+		DatakeyCipherLength: "32",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, strings.ToLower(dataKey.PlainText), strings.ToLower(decrypted.DataKey))
+}
@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/ingestion"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/streams"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestLtsIngestion(t *testing.T) {
+	client, err := clients.NewLtsV2Client()
+	th.AssertNoErr(t, err)
+
+	groupId, err := groups.CreateLogGroup(client, groups.CreateOpts{
+		LogGroupName: tools.RandomString("test-group-", 3),
+		TTLInDays:    7,
+	})
+	th.AssertNoErr(t, err)
+	t.Cleanup(func() {
+		th.AssertNoErr(t, groups.DeleteLogGroup(client, groupId))
+	})
+
+	streamId, err := streams.CreateLogStream(client, streams.CreateOpts{
+		GroupId:       groupId,
+		LogStreamName: tools.RandomString("test-stream-", 3),
+	})
+	th.AssertNoErr(t, err)
+	t.Cleanup(func() {
+		th.AssertNoErr(t, streams.DeleteLogStream(client, streams.DeleteOpts{
+			GroupId:  groupId,
+			StreamId: streamId,
+		}))
+	})
+
+	ingest, err := ingestion.NewClient(client, ingestion.ClientOpts{
+		GroupId:  groupId,
+		StreamId: streamId,
+		Labels:   map[string]string{"test": "acceptance"},
+	})
+	th.AssertNoErr(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err := fmt.Fprintf(ingest, "acceptance event %d\n", i)
+		th.AssertNoErr(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	th.AssertNoErr(t, ingest.Close(ctx))
+	tools.PrintResource(t, ingest.Stats())
+	th.AssertEquals(t, uint64(10), ingest.Stats().Sent)
+
+	// the reported logs are searchable with a delay
+	it := streams.Search(client, streams.SearchOpts{
+		GroupId:   groupId,
+		StreamId:  streamId,
+		StartTime: time.Now().Add(-time.Hour),
+		Keywords:  "acceptance",
+	})
+	for it.Next() {
+		tools.PrintResource(t, it.Log())
+	}
+	th.AssertNoErr(t, it.Err())
+}
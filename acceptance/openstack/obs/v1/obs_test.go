@@ -3,6 +3,7 @@ package v1
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -371,3 +372,260 @@ func TestOBSInventories(t *testing.T) {
 	})
 	th.AssertNoErr(t, err)
 }
+
+type countingListener struct {
+	consumed int64
+	events   []obs.ProgressEventType
+}
+
+func (l *countingListener) ProgressChanged(event *obs.ProgressEvent) {
+	l.consumed = event.ConsumedBytes
+	if event.EventType != obs.TransferDataEvent {
+		l.events = append(l.events, event.EventType)
+	}
+}
+
+func TestOBSTransferProgress(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test-", 5))
+	objectName := tools.RandomString("test-obs-", 5)
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	t.Cleanup(func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+
+	content := strings.Repeat("progress", 1024)
+	listener := &countingListener{}
+	_, err = client.PutObject(&obs.PutObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket: bucketName,
+				Key:    objectName,
+			},
+			ContentLength: int64(len(content)),
+		},
+		Body:             strings.NewReader(content),
+		ProgressListener: listener,
+		RateLimiter:      obs.NewRateLimiter(4096),
+	})
+	t.Cleanup(func() {
+		_, err = client.DeleteObject(&obs.DeleteObjectInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		})
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(content)), listener.consumed)
+	th.AssertDeepEquals(t, []obs.ProgressEventType{obs.TransferStartedEvent, obs.TransferCompletedEvent}, listener.events)
+}
+
+func TestOBSObjectTaggingAndAppend(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test-", 5))
+	objectName := tools.RandomString("test-obs-", 5)
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	t.Cleanup(func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+
+	first, err := client.AppendObject(&obs.AppendObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket: bucketName,
+				Key:    objectName,
+			},
+		},
+		Body: strings.NewReader("first line\n"),
+	})
+	t.Cleanup(func() {
+		_, err = client.DeleteObject(&obs.DeleteObjectInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		})
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(11), first.NextAppendPosition)
+
+	second, err := client.AppendObject(&obs.AppendObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket: bucketName,
+				Key:    objectName,
+			},
+		},
+		Body:     strings.NewReader("second line\n"),
+		Position: first.NextAppendPosition,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(23), second.NextAppendPosition)
+
+	_, err = client.SetObjectTagging(&obs.SetObjectTaggingInput{
+		Bucket: bucketName,
+		Key:    objectName,
+		BucketTagging: obs.BucketTagging{
+			Tags: []obs.Tag{{Key: "lifecycle", Value: "logs"}},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	tagging, err := client.GetObjectTagging(&obs.GetObjectTaggingInput{
+		Bucket: bucketName,
+		Key:    objectName,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(tagging.Tags))
+	th.AssertEquals(t, "logs", tagging.Tags[0].Value)
+
+	_, err = client.DeleteObjectTagging(&obs.DeleteObjectTaggingInput{
+		Bucket: bucketName,
+		Key:    objectName,
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestOBSSync(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test-", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	t.Cleanup(func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+
+	source := t.TempDir()
+	th.AssertNoErr(t, os.MkdirAll(filepath.Join(source, "lib"), 0755))
+	th.AssertNoErr(t, os.WriteFile(filepath.Join(source, "app.bin"), []byte("binary"), 0644))
+	th.AssertNoErr(t, os.WriteFile(filepath.Join(source, "lib", "util.so"), []byte("library"), 0644))
+	th.AssertNoErr(t, os.WriteFile(filepath.Join(source, "build.log"), []byte("log"), 0644))
+
+	input := &obs.SyncInput{
+		Bucket:           bucketName,
+		Prefix:           "artifacts",
+		LocalDir:         source,
+		Direction:        obs.SyncUpload,
+		Exclude:          []string{"*.log"},
+		DeleteExtraneous: true,
+		TaskNum:          2,
+	}
+	output, err := client.Sync(input)
+	t.Cleanup(func() {
+		input.LocalDir = t.TempDir()
+		_, err = client.Sync(input)
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, output.Uploaded)
+	th.AssertEquals(t, 0, output.Failed)
+
+	output, err = client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(output.Actions))
+	th.AssertEquals(t, 2, output.Unchanged)
+
+	target := t.TempDir()
+	output, err = client.Sync(&obs.SyncInput{
+		Bucket:    bucketName,
+		Prefix:    "artifacts",
+		LocalDir:  target,
+		Direction: obs.SyncDownload,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, output.Downloaded)
+
+	data, err := os.ReadFile(filepath.Join(target, "lib", "util.so"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "library", string(data))
+}
+
+func TestOBSEmptyVersionedBucket(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test-", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	t.Cleanup(func() {
+		th.AssertNoErr(t, client.EmptyBucket(bucketName))
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	})
+	th.AssertNoErr(t, err)
+
+	_, err = client.SetBucketVersioning(&obs.SetBucketVersioningInput{
+		Bucket: bucketName,
+		BucketVersioningConfiguration: obs.BucketVersioningConfiguration{
+			Status: obs.VersioningStatusEnabled,
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = client.PutObject(&obs.PutObjectInput{
+			PutObjectBasicInput: obs.PutObjectBasicInput{
+				ObjectOperationInput: obs.ObjectOperationInput{
+					Bucket: bucketName,
+					Key:    fmt.Sprintf("dir/object-%d", i%2),
+				},
+			},
+			Body: strings.NewReader(fmt.Sprintf("version %d", i)),
+		})
+		th.AssertNoErr(t, err)
+	}
+	_, err = client.DeleteObject(&obs.DeleteObjectInput{
+		Bucket: bucketName,
+		Key:    "dir/object-0",
+	})
+	th.AssertNoErr(t, err)
+
+	listInput := &obs.ListVersionsInput{Bucket: bucketName}
+	listInput.MaxKeys = 1
+	versions, markers := 0, 0
+	it := client.NewVersionIterator(listInput)
+	for it.Next() {
+		if it.Version() != nil {
+			versions++
+		}
+		if it.DeleteMarker() != nil {
+			markers++
+		}
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertEquals(t, 3, versions)
+	th.AssertEquals(t, 1, markers)
+
+	objectsInput := &obs.ListObjectsInput{Bucket: bucketName}
+	objectsInput.Delimiter = "/"
+	objects := client.NewObjectIterator(objectsInput)
+	var prefixes []string
+	for objects.Next() {
+		if objects.CommonPrefix() != "" {
+			prefixes = append(prefixes, objects.CommonPrefix())
+		}
+	}
+	th.AssertNoErr(t, objects.Err())
+	th.AssertDeepEquals(t, []string{"dir/"}, prefixes)
+}
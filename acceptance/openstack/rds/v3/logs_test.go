@@ -0,0 +1,76 @@
+package v3
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/logs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsAuditAndLogFiles(t *testing.T) {
+	if os.Getenv("RUN_RDS_LIFECYCLE") == "" {
+		t.Skip("too slow to run in zuul")
+	}
+
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create MySql RDSv3 instance
+	rds := CreateMySqlRDS(t, client, cc.RegionName)
+	t.Cleanup(func() { DeleteRDS(t, client, rds.Id) })
+
+	err = logs.SetAuditPolicy(client, logs.SetAuditPolicyOpts{
+		InstanceId: rds.Id,
+		KeepDays:   pointerto.Int(7),
+	})
+	th.AssertNoErr(t, err)
+
+	policy, err := logs.GetAuditPolicy(client, rds.Id)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 7, policy.KeepDays)
+
+	now := time.Now().UTC()
+	auditLogs, err := logs.ListAuditLogs(client, logs.ListAuditLogsOpts{
+		InstanceId: rds.Id,
+		StartTime:  now.Add(-24 * time.Hour).Format("2006-01-02T15:04:05-0700"),
+		EndTime:    now.Format("2006-01-02T15:04:05-0700"),
+		Offset:     0,
+		Limit:      10,
+	})
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, auditLogs)
+
+	stats, err := logs.SlowLogStatistics(client, logs.SlowLogStatisticsOpts{
+		InstanceId: rds.Id,
+		StartDate:  now.Add(-24 * time.Hour).Format("2006-01-02T15:04:05-0700"),
+		EndDate:    now.Format("2006-01-02T15:04:05-0700"),
+	})
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, stats)
+
+	links, err := logs.GetSlowLogLinks(client, logs.LogFileLinksOpts{InstanceId: rds.Id})
+	th.AssertNoErr(t, err)
+	for _, file := range links.List {
+		if file.Status != "FINISH" {
+			continue
+		}
+		var buf bytes.Buffer
+		_, err := logs.DownloadLogFile(client, file.FileLink, &buf)
+		th.AssertNoErr(t, err)
+	}
+
+	err = logs.SetAuditPolicy(client, logs.SetAuditPolicyOpts{
+		InstanceId: rds.Id,
+		KeepDays:   pointerto.Int(0),
+	})
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,114 @@
+package v3
+
+import (
+	"os"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/databases"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsDatabasesUsersLifecycle(t *testing.T) {
+	if os.Getenv("RUN_RDS_LIFECYCLE") == "" {
+		t.Skip("too slow to run in zuul")
+	}
+
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	t.Log("Creating instance")
+
+	// Create MySql RDSv3 instance
+	rds := CreateMySqlRDS(t, client, cc.RegionName)
+	t.Cleanup(func() { DeleteRDS(t, client, rds.Id) })
+
+	dbName := tools.RandomString("rds_db_", 4)
+	err = databases.Create(client, databases.CreateOpts{
+		InstanceId:   rds.Id,
+		Name:         dbName,
+		CharacterSet: "utf8",
+	})
+	th.AssertNoErr(t, err)
+	t.Cleanup(func() {
+		th.AssertNoErr(t, databases.Delete(client, rds.Id, dbName))
+	})
+
+	dbList, err := databases.ListAll(client, rds.Id)
+	th.AssertNoErr(t, err)
+	found := false
+	for _, db := range dbList {
+		if db.Name == dbName {
+			found = true
+			th.AssertEquals(t, "utf8", db.CharacterSet)
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	userName := tools.RandomString("rds_user_", 4)
+	err = users.Create(client, users.CreateOpts{
+		InstanceId: rds.Id,
+		Name:       userName,
+		Password:   "acc-test-password1!",
+	})
+	th.AssertNoErr(t, err)
+	t.Cleanup(func() {
+		th.AssertNoErr(t, users.Delete(client, rds.Id, userName))
+	})
+
+	err = users.Grant(client, users.GrantOpts{
+		InstanceId: rds.Id,
+		DbName:     dbName,
+		Users:      []users.GrantUser{{Name: userName, Readonly: true}},
+	})
+	th.AssertNoErr(t, err)
+
+	authorized, err := users.ListAuthorizedDatabases(client, users.ListAuthorizedDatabasesOpts{
+		InstanceId: rds.Id,
+		UserName:   userName,
+		Page:       1,
+		Limit:      10,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(authorized.Databases))
+	th.AssertEquals(t, dbName, authorized.Databases[0].Name)
+	th.AssertEquals(t, true, authorized.Databases[0].Readonly)
+
+	authorizedUsers, err := databases.ListAuthorizedUsers(client, databases.ListAuthorizedUsersOpts{
+		InstanceId: rds.Id,
+		DbName:     dbName,
+		Page:       1,
+		Limit:      10,
+	})
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, authorizedUsers)
+
+	err = users.Revoke(client, users.RevokeOpts{
+		InstanceId: rds.Id,
+		DbName:     dbName,
+		Users:      []users.RevokeUser{{Name: userName}},
+	})
+	th.AssertNoErr(t, err)
+
+	err = users.ResetPassword(client, users.ResetPasswordOpts{
+		InstanceId: rds.Id,
+		Name:       userName,
+		Password:   "acc-test-password2!",
+	})
+	th.AssertNoErr(t, err)
+
+	userList, err := users.ListAll(client, rds.Id)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, userList)
+
+	err = users.ResetRootPassword(client, users.ResetRootPasswordOpts{
+		InstanceId: rds.Id,
+		DbUserPwd:  "acc-test-password2!",
+	})
+	th.AssertNoErr(t, err)
+}
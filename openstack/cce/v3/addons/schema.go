@@ -0,0 +1,426 @@
+package addons
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ValidationError describes an invalid addon value.
+type ValidationError struct {
+	// Path of the value, e.g. values.custom.coresTotal
+	Path string
+	// What is wrong with the value
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationErrors lists all invalid values, ordered by their paths.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return "invalid addon values: " + strings.Join(messages, "; ")
+}
+
+// TemplateSchema is the input of an addon template version. The template contains the default
+// values only, the expected types of the values are derived from them.
+type TemplateSchema struct {
+	// Default basic values
+	Basic map[string]interface{}
+	// Default custom values
+	Custom map[string]interface{}
+	// Flavors by their keys in the template, e.g. flavor1
+	Flavors map[string]map[string]interface{}
+}
+
+// Schema returns the input schema of the template version.
+func (v *Version) Schema() *TemplateSchema {
+	schema := &TemplateSchema{
+		Flavors: map[string]map[string]interface{}{},
+	}
+	schema.Basic, _ = v.Input["basic"].(map[string]interface{})
+	parameters, _ := v.Input["parameters"].(map[string]interface{})
+	schema.Custom, _ = parameters["custom"].(map[string]interface{})
+	for key, value := range parameters {
+		if flavor, ok := value.(map[string]interface{}); ok && strings.HasPrefix(key, "flavor") {
+			schema.Flavors[key] = flavor
+		}
+	}
+	return schema
+}
+
+// SupportsCluster checks whether the version can be installed in a cluster of the given type and
+// version, e.g. VirtualMachine and v1.25. An empty clusterType matches any type.
+func (v *Version) SupportsCluster(clusterType, clusterVersion string) bool {
+	versions := []string{clusterVersion}
+	// cluster versions are often given as patterns of the minor version
+	if parts := strings.SplitN(clusterVersion, ".", 3); len(parts) == 3 {
+		versions = append(versions, parts[0]+"."+parts[1])
+	}
+	for _, support := range v.SupportVersions {
+		if clusterType != "" && support.ClusterType != clusterType {
+			continue
+		}
+		for _, pattern := range support.ClusterVersion {
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				continue
+			}
+			for _, version := range versions {
+				if re.MatchString(version) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// FindVersion returns the given version of the template, checking it supports the cluster.
+// If version is empty, the latest stable version supporting the cluster is returned.
+func (t *AddonTemplate) FindVersion(version, clusterType, clusterVersion string) (*Version, error) {
+	var found *Version
+	for i, v := range t.Spec.Versions {
+		if version != "" {
+			if v.Version == version {
+				found = &t.Spec.Versions[i]
+				break
+			}
+			continue
+		}
+		if v.Stable && v.SupportsCluster(clusterType, clusterVersion) &&
+			(found == nil || compareVersions(v.Version, found.Version) > 0) {
+			found = &t.Spec.Versions[i]
+		}
+	}
+	if found == nil {
+		if version != "" {
+			return nil, fmt.Errorf("version %s of addon %s not found", version, t.Metadata.Name)
+		}
+		return nil, fmt.Errorf("no stable version of addon %s supports cluster version %s", t.Metadata.Name, clusterVersion)
+	}
+	if !found.SupportsCluster(clusterType, clusterVersion) {
+		return nil, fmt.Errorf("version %s of addon %s doesn't support cluster version %s", found.Version, t.Metadata.Name, clusterVersion)
+	}
+	return found, nil
+}
+
+// compareVersions compares dotted version strings numerically part by part.
+func compareVersions(a, b string) int {
+	split := func(r rune) bool { return r == '.' || r == '-' }
+	partsA, partsB := strings.FieldsFunc(a, split), strings.FieldsFunc(b, split)
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numA, errA := strconv.Atoi(partsA[i])
+		numB, errB := strconv.Atoi(partsB[i])
+		switch {
+		case errA == nil && errB == nil && numA != numB:
+			if numA < numB {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && partsA[i] != partsB[i]:
+			return strings.Compare(partsA[i], partsB[i])
+		}
+	}
+	return len(partsA) - len(partsB)
+}
+
+type TemplateValuesOpts struct {
+	// ID of the cluster the addon is installed in
+	ClusterID string
+	// Type of the cluster, e.g. VirtualMachine. The type is not checked if empty
+	ClusterType string
+	// Version of the cluster, e.g. v1.25
+	ClusterVersion string
+	// Version of the addon, defaults to the latest stable version supporting the cluster
+	Version string
+	// Values overriding the defaults of the template. The flavor values are merged into the
+	// flavor of the template with the same name, the first flavor of the template if not set.
+	Values Values
+	// Paths of the values which must be set as the template has no usable defaults for them,
+	// e.g. custom.cluster_id. Values with null defaults in the template are always required.
+	Required []string
+}
+
+// CreateOpts merges the values with the defaults of the template and validates them against
+// the template schema. Invalid values result in ValidationErrors.
+func (t *AddonTemplate) CreateOpts(opts TemplateValuesOpts) (*CreateOpts, error) {
+	if opts.ClusterID == "" {
+		return nil, fmt.Errorf("cluster ID is required")
+	}
+	version, err := t.FindVersion(opts.Version, opts.ClusterType, opts.ClusterVersion)
+	if err != nil {
+		return nil, err
+	}
+	schema := version.Schema()
+
+	v := &valuesValidator{}
+	values := Values{
+		Basic:    v.merge("values.basic", schema.Basic, opts.Values.Basic),
+		Advanced: v.merge("values.custom", schema.Custom, opts.Values.Advanced),
+	}
+
+	flavorKey, err := schema.selectFlavor(opts.Values.Flavor)
+	if err != nil {
+		v.add("values.flavor.name", err.Error())
+	} else if flavorKey != "" || opts.Values.Flavor != nil {
+		values.Flavor = v.merge("values.flavor", schema.Flavors[flavorKey], opts.Values.Flavor)
+	}
+
+	for _, path := range opts.Required {
+		v.checkRequired(path, values)
+	}
+	if len(v.errors) > 0 {
+		sort.SliceStable(v.errors, func(i, j int) bool { return v.errors[i].Path < v.errors[j].Path })
+		return nil, v.errors
+	}
+
+	return &CreateOpts{
+		Kind:       "Addon",
+		ApiVersion: "v3",
+		Metadata: CreateMetadata{
+			Annotations: CreateAnnotations{AddonInstallType: "install"},
+		},
+		Spec: RequestSpec{
+			Version:           version.Version,
+			ClusterID:         opts.ClusterID,
+			AddonTemplateName: t.Metadata.Name,
+			Values:            values,
+		},
+	}, nil
+}
+
+// selectFlavor returns the key of the template flavor with the name of the given flavor values,
+// or of the first flavor if no name is set.
+func (s *TemplateSchema) selectFlavor(flavor map[string]interface{}) (string, error) {
+	if len(s.Flavors) == 0 {
+		return "", nil
+	}
+	keys := make([]string, 0, len(s.Flavors))
+	for key := range s.Flavors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	name, ok := flavor["name"]
+	if !ok {
+		return keys[0], nil
+	}
+	var names []string
+	for _, key := range keys {
+		flavorName := fmt.Sprint(s.Flavors[key]["name"])
+		if flavorName == fmt.Sprint(name) {
+			return key, nil
+		}
+		names = append(names, flavorName)
+	}
+	return "", fmt.Errorf("unknown flavor %v, available flavors: %s", name, strings.Join(names, ", "))
+}
+
+// TemplateCreateOpts reads the addon template from the cluster and builds validated CreateOpts,
+// see AddonTemplate.CreateOpts.
+func TemplateCreateOpts(client *golangsdk.ServiceClient, templateName string, opts TemplateValuesOpts) (*CreateOpts, error) {
+	templates, err := ListTemplates(client, opts.ClusterID, ListOpts{Name: templateName}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, template := range templates.Items {
+		if template.Metadata.Name == templateName {
+			return template.CreateOpts(opts)
+		}
+	}
+	return nil, fmt.Errorf("addon template %s not found", templateName)
+}
+
+type valuesValidator struct {
+	errors ValidationErrors
+}
+
+func (v *valuesValidator) add(path, message string) {
+	v.errors = append(v.errors, &ValidationError{Path: path, Message: message})
+}
+
+// merge validates the values against the defaults and returns the defaults overridden by the values.
+func (v *valuesValidator) merge(path string, defaults, values map[string]interface{}) map[string]interface{} {
+	normalized, err := normalizeValues(values)
+	if err != nil {
+		v.add(path, err.Error())
+		return nil
+	}
+	if defaults == nil {
+		// no schema, e.g. a template without custom parameters
+		return normalized
+	}
+	return v.mergeObject(path, defaults, normalized)
+}
+
+func (v *valuesValidator) mergeObject(path string, defaults, values map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaults)+len(values))
+	for _, key := range sortedKeys(defaults) {
+		result[key] = defaults[key]
+		if _, ok := values[key]; !ok && defaults[key] == nil {
+			v.add(path+"."+key, "value is required")
+		}
+	}
+
+	for _, key := range sortedKeys(values) {
+		value := values[key]
+		fieldPath := path + "." + key
+		def, known := defaults[key]
+		switch {
+		case !known && len(defaults) > 0:
+			v.add(fieldPath, unknownFieldMessage(key, defaults))
+			continue
+		case !known || def == nil:
+			// free-form object or value without a default
+		case value == nil:
+			// explicit null is passed as is
+		case valueType(def) != valueType(value):
+			v.add(fieldPath, fmt.Sprintf("expected %s, got %s", valueType(def), valueType(value)))
+			continue
+		}
+
+		defObject, isDefObject := def.(map[string]interface{})
+		object, isObject := value.(map[string]interface{})
+		if isDefObject && isObject {
+			value = v.mergeObject(fieldPath, defObject, object)
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// checkRequired checks the value at the path relative to the values is set.
+func (v *valuesValidator) checkRequired(path string, values Values) {
+	parts := strings.Split(path, ".")
+	var current interface{}
+	switch parts[0] {
+	case "basic":
+		current = values.Basic
+	case "custom":
+		current = values.Advanced
+	case "flavor":
+		current = values.Flavor
+	default:
+		v.add("values."+path, "unknown section, expected basic, custom or flavor")
+		return
+	}
+	for _, part := range parts[1:] {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			current = nil
+			break
+		}
+		current = object[part]
+	}
+	if current == nil || current == "" {
+		v.add("values."+path, "value is required")
+	}
+}
+
+// normalizeValues converts the values to the form of decoded JSON, so that they can be compared
+// with the template defaults. Numbers are kept as json.Number to preserve their precision.
+func normalizeValues(values map[string]interface{}) (map[string]interface{}, error) {
+	if values == nil {
+		return map[string]interface{}{}, nil
+	}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var normalized map[string]interface{}
+	err = decoder.Decode(&normalized)
+	return normalized, err
+}
+
+func valueType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func unknownFieldMessage(key string, defaults map[string]interface{}) string {
+	best, bestDistance := "", len(key)/3+1
+	for known := range defaults {
+		if strings.EqualFold(known, key) {
+			best = known
+			break
+		}
+		if distance := editDistance(strings.ToLower(known), strings.ToLower(key)); distance <= bestDistance {
+			if best == "" || distance < bestDistance || known < best {
+				best, bestDistance = known, distance
+			}
+		}
+	}
+	if best != "" {
+		return fmt.Sprintf("unknown field, did you mean %s?", best)
+	}
+	return "unknown field, expected one of: " + strings.Join(sortedKeys(defaults), ", ")
+}
+
+// editDistance returns the Levenshtein distance of the strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
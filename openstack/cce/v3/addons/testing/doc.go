@@ -0,0 +1,2 @@
+// addons unit tests
+package testing
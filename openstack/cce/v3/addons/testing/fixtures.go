@@ -0,0 +1,75 @@
+package testing
+
+const TemplateOutput = `
+{
+    "kind": "Addon",
+    "apiVersion": "v3",
+    "metadata": {
+        "name": "autoscaler",
+        "uid": "autoscaler"
+    },
+    "spec": {
+        "type": "helm",
+        "require": false,
+        "labels": ["ElasticScaling"],
+        "description": "Autoscaler",
+        "versions": [
+            {
+                "version": "1.19.6",
+                "stable": true,
+                "supportVersions": [
+                    {"clusterType": "VirtualMachine", "clusterVersion": ["v1.19.*"]}
+                ],
+                "input": {
+                    "basic": {"swr_addr": "100.125.7.25:20202", "swr_user": "hwofficial"}
+                }
+            },
+            {
+                "version": "1.23.3",
+                "stable": true,
+                "supportVersions": [
+                    {"clusterType": "VirtualMachine", "clusterVersion": ["v1.23.*", "v1.25.*"]}
+                ],
+                "input": {
+                    "basic": {
+                        "cceEndpoint": "https://cce.eu-de.otc.t-systems.com",
+                        "region": "eu-de",
+                        "swr_addr": "100.125.7.25:20202",
+                        "swr_user": "hwofficial"
+                    },
+                    "parameters": {
+                        "custom": {
+                            "cluster_id": "",
+                            "tenant_id": "",
+                            "coresTotal": 32000,
+                            "scaleDownEnabled": false,
+                            "scaleDownUtilizationThreshold": 0.5,
+                            "tolerations": [],
+                            "logging": {"level": "info", "format": "text"},
+                            "expander": null
+                        },
+                        "flavor1": {
+                            "name": 1,
+                            "replicas": 1,
+                            "resources": [{"name": "autoscaler", "limitsCpu": "1000m"}]
+                        },
+                        "flavor2": {
+                            "name": 2,
+                            "replicas": 2,
+                            "resources": [{"name": "autoscaler", "limitsCpu": "2000m"}]
+                        }
+                    }
+                }
+            },
+            {
+                "version": "1.25.0",
+                "stable": false,
+                "supportVersions": [
+                    {"clusterType": "VirtualMachine", "clusterVersion": ["v1.25.*"]}
+                ],
+                "input": {}
+            }
+        ]
+    }
+}
+`
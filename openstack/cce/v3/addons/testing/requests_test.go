@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/addons"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func template(t *testing.T) *addons.AddonTemplate {
+	var template addons.AddonTemplate
+	th.AssertNoErr(t, json.Unmarshal([]byte(TemplateOutput), &template))
+	return &template
+}
+
+func TestFindVersion(t *testing.T) {
+	tmpl := template(t)
+
+	version, err := tmpl.FindVersion("", "VirtualMachine", "v1.25.3-r0")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "1.23.3", version.Version)
+
+	version, err = tmpl.FindVersion("", "", "v1.19")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "1.19.6", version.Version)
+
+	_, err = tmpl.FindVersion("1.19.6", "VirtualMachine", "v1.25")
+	th.AssertEquals(t, true, err != nil)
+	_, err = tmpl.FindVersion("", "BareMetal", "v1.25")
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestTemplateCreateOpts(t *testing.T) {
+	opts, err := template(t).CreateOpts(addons.TemplateValuesOpts{
+		ClusterID:      "cec124c2-58f1-11e8-ad73-0255ac101926",
+		ClusterVersion: "v1.25",
+		Values: addons.Values{
+			Basic: map[string]interface{}{"region": "eu-nl"},
+			Advanced: map[string]interface{}{
+				"cluster_id":       "cec124c2-58f1-11e8-ad73-0255ac101926",
+				"tenant_id":        "c59fd21fd2a94963b822d8985b884673",
+				"coresTotal":       64000,
+				"scaleDownEnabled": true,
+				"logging":          map[string]string{"level": "debug"},
+				"expander":         "priority",
+			},
+			Flavor: map[string]interface{}{"name": 2},
+		},
+		Required: []string{"custom.cluster_id", "custom.tenant_id"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "1.23.3", opts.Spec.Version)
+	th.AssertEquals(t, "autoscaler", opts.Spec.AddonTemplateName)
+	th.AssertEquals(t, "install", opts.Metadata.Annotations.AddonInstallType)
+
+	th.AssertEquals(t, "eu-nl", opts.Spec.Values.Basic["region"])
+	th.AssertEquals(t, "hwofficial", opts.Spec.Values.Basic["swr_user"])
+	th.AssertEquals(t, json.Number("64000"), opts.Spec.Values.Advanced["coresTotal"])
+	th.AssertEquals(t, 0.5, opts.Spec.Values.Advanced["scaleDownUtilizationThreshold"])
+	th.AssertDeepEquals(t, map[string]interface{}{"level": "debug", "format": "text"}, opts.Spec.Values.Advanced["logging"])
+	th.AssertEquals(t, 2.0, opts.Spec.Values.Flavor["replicas"])
+
+	_, err = opts.ToAddonCreateMap()
+	th.AssertNoErr(t, err)
+}
+
+func TestTemplateCreateOptsInvalid(t *testing.T) {
+	_, err := template(t).CreateOpts(addons.TemplateValuesOpts{
+		ClusterID:      "cec124c2-58f1-11e8-ad73-0255ac101926",
+		ClusterVersion: "v1.23",
+		Values: addons.Values{
+			Basic: map[string]interface{}{"regoin": "eu-nl"},
+			Advanced: map[string]interface{}{
+				"cluster_id": "cec124c2-58f1-11e8-ad73-0255ac101926",
+				"coresTotal": "64000",
+				"logging":    map[string]interface{}{"level": 1},
+			},
+			Flavor: map[string]interface{}{"name": 3},
+		},
+		Required: []string{"custom.cluster_id", "custom.tenant_id"},
+	})
+	errs, ok := err.(addons.ValidationErrors)
+	th.AssertEquals(t, true, ok)
+
+	expected := []addons.ValidationError{
+		{Path: "values.basic.regoin", Message: "unknown field, did you mean region?"},
+		{Path: "values.custom.coresTotal", Message: "expected number, got string"},
+		{Path: "values.custom.expander", Message: "value is required"},
+		{Path: "values.custom.logging.level", Message: "expected string, got number"},
+		{Path: "values.custom.tenant_id", Message: "value is required"},
+		{Path: "values.flavor.name", Message: "unknown flavor 3, available flavors: 1, 2"},
+	}
+	th.AssertEquals(t, len(expected), len(errs))
+	for i := range expected {
+		th.AssertDeepEquals(t, expected[i], *errs[i])
+	}
+}
@@ -51,5 +51,22 @@ Example to Delete a cluster
 	if err != nil {
 		panic(err)
 	}
+
+Example to merge the cluster into ~/.kube/config
+
+	cert, err := clusters.GetCert(client, clusterID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	config, err := cert.SelectContext(clusters.KubeConfigOpts{Context: clusters.ContextExternalTLSVerify, Name: "my-cluster"})
+	if err != nil {
+		panic(err)
+	}
+
+	err = clusters.WriteKubeConfig("", config, true)
+	if err != nil {
+		panic(err)
+	}
 */
 package clusters
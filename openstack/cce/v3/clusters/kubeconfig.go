@@ -0,0 +1,238 @@
+package clusters
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ContextInternal accesses the cluster through its private network address.
+	ContextInternal = "internal"
+	// ContextExternal accesses the cluster through its EIP without verifying the server certificate.
+	ContextExternal = "external"
+	// ContextExternalTLSVerify accesses the cluster through its EIP verifying the server certificate.
+	ContextExternalTLSVerify = "externalTLSVerify"
+)
+
+type KubeConfigOpts struct {
+	// Context of the certificate to use, one of ContextInternal, ContextExternal and
+	// ContextExternalTLSVerify. Defaults to the current context of the certificate.
+	Context string
+	// Name of the cluster, user and context in the kubeconfig, e.g. the cluster name.
+	// Defaults to the names used in the certificate.
+	Name string
+}
+
+// SelectContext returns a kubeconfig containing only the given context of the certificate,
+// its cluster and user. The context is set as the current one.
+func (c *Certificate) SelectContext(opts KubeConfigOpts) (*Certificate, error) {
+	contextName := opts.Context
+	if contextName == "" {
+		contextName = c.CurrentContext
+	}
+
+	var selected *CertContexts
+	var names []string
+	for i, ctx := range c.Contexts {
+		names = append(names, ctx.Name)
+		if ctx.Name == contextName {
+			selected = &c.Contexts[i]
+		}
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("context %q not found in the certificate, available contexts: %s", contextName, strings.Join(names, ", "))
+	}
+
+	result := &Certificate{
+		Kind:           c.Kind,
+		ApiVersion:     c.ApiVersion,
+		Contexts:       []CertContexts{*selected},
+		CurrentContext: selected.Name,
+	}
+	for _, cluster := range c.Clusters {
+		if cluster.Name == selected.Context.Cluster {
+			result.Clusters = append(result.Clusters, cluster)
+			break
+		}
+	}
+	if len(result.Clusters) == 0 {
+		return nil, fmt.Errorf("cluster %q of context %q not found in the certificate", selected.Context.Cluster, selected.Name)
+	}
+	for _, user := range c.Users {
+		if user.Name == selected.Context.User {
+			result.Users = append(result.Users, user)
+			break
+		}
+	}
+	if len(result.Users) == 0 {
+		return nil, fmt.Errorf("user %q of context %q not found in the certificate", selected.Context.User, selected.Name)
+	}
+
+	if opts.Name != "" {
+		result.Clusters[0].Name = opts.Name
+		result.Users[0].Name = opts.Name
+		result.Contexts[0] = CertContexts{Name: opts.Name, Context: CertContext{Cluster: opts.Name, User: opts.Name}}
+		result.CurrentContext = opts.Name
+	}
+	if result.Kind == "" {
+		result.Kind = "Config"
+	}
+	if result.ApiVersion == "" {
+		result.ApiVersion = "v1"
+	}
+	return result, nil
+}
+
+// KubeConfig renders the given context of the certificate as a kubeconfig YAML document.
+func (c *Certificate) KubeConfig(opts KubeConfigOpts) ([]byte, error) {
+	config, err := c.SelectContext(opts)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(config)
+}
+
+// MergeKubeConfig merges the clusters, users and contexts of config into the existing kubeconfig
+// YAML document, replacing the entries with the same names. Other entries and fields of the existing
+// kubeconfig are kept. The current context is replaced only if setCurrentContext is true or it is
+// not set yet.
+func MergeKubeConfig(existing []byte, config *Certificate, setCurrentContext bool) ([]byte, error) {
+	var document yaml.MapSlice
+	if err := yaml.Unmarshal(existing, &document); err != nil {
+		return nil, fmt.Errorf("error parsing existing kubeconfig: %w", err)
+	}
+
+	var err error
+	for _, section := range []struct {
+		key     string
+		entries interface{}
+	}{
+		{"clusters", config.Clusters},
+		{"users", config.Users},
+		{"contexts", config.Contexts},
+	} {
+		document, err = mergeKubeConfigSection(document, section.key, section.entries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	current, _ := kubeConfigValue(document, "current-context").(string)
+	if setCurrentContext || current == "" {
+		document = setKubeConfigValue(document, "current-context", config.CurrentContext)
+	}
+	if kubeConfigValue(document, "apiVersion") == nil {
+		document = setKubeConfigValue(document, "apiVersion", "v1")
+	}
+	if kubeConfigValue(document, "kind") == nil {
+		document = setKubeConfigValue(document, "kind", "Config")
+	}
+	return yaml.Marshal(document)
+}
+
+// mergeKubeConfigSection merges the named entries into a list of the document.
+func mergeKubeConfigSection(document yaml.MapSlice, key string, entries interface{}) (yaml.MapSlice, error) {
+	// convert the typed entries to the generic form used by the document
+	raw, err := yaml.Marshal(entries)
+	if err != nil {
+		return nil, err
+	}
+	var added []yaml.MapSlice
+	if err := yaml.Unmarshal(raw, &added); err != nil {
+		return nil, err
+	}
+
+	var list []interface{}
+	if value := kubeConfigValue(document, key); value != nil {
+		var ok bool
+		if list, ok = value.([]interface{}); !ok {
+			return nil, fmt.Errorf("invalid kubeconfig: %s is not a list", key)
+		}
+	}
+	for _, entry := range added {
+		name := kubeConfigValue(entry, "name")
+		replaced := false
+		for i, item := range list {
+			if existing, ok := item.(yaml.MapSlice); ok && kubeConfigValue(existing, "name") == name {
+				list[i] = entry
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			list = append(list, entry)
+		}
+	}
+	return setKubeConfigValue(document, key, list), nil
+}
+
+func kubeConfigValue(document yaml.MapSlice, key string) interface{} {
+	for _, item := range document {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+func setKubeConfigValue(document yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+	for i, item := range document {
+		if item.Key == key {
+			document[i].Value = value
+			return document
+		}
+	}
+	return append(document, yaml.MapItem{Key: key, Value: value})
+}
+
+// DefaultKubeConfigPath returns the first path of the KUBECONFIG environment variable,
+// or ~/.kube/config if it is not set.
+func DefaultKubeConfigPath() (string, error) {
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		return filepath.SplitList(env)[0], nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+// WriteKubeConfig writes config to the kubeconfig file at path, DefaultKubeConfigPath if empty.
+// With merge, config is merged into the existing file with MergeKubeConfig, making its context
+// the current one. The file is replaced atomically and is readable by the owner only.
+func WriteKubeConfig(path string, config *Certificate, merge bool) error {
+	if path == "" {
+		var err error
+		if path, err = DefaultKubeConfigPath(); err != nil {
+			return err
+		}
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if merge {
+		existing, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if data, err = MergeKubeConfig(existing, config, true); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, path)
+}
@@ -0,0 +1,167 @@
+package clusters
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RestConfig contains the fields of a Kubernetes REST client configuration (rest.Config of
+// k8s.io/client-go) for accessing the cluster with a client certificate.
+type RestConfig struct {
+	// Host is the API server address, rest.Config.Host
+	Host string
+	// CAData is the PEM encoded CA certificate, rest.Config.TLSClientConfig.CAData
+	CAData []byte
+	// CertData is the PEM encoded client certificate, rest.Config.TLSClientConfig.CertData
+	CertData []byte
+	// KeyData is the PEM encoded client key, rest.Config.TLSClientConfig.KeyData
+	KeyData []byte
+	// Insecure disables the server certificate verification, rest.Config.TLSClientConfig.Insecure
+	Insecure bool
+	// Expiry is the expiration time of the client certificate
+	Expiry time.Time
+}
+
+// RestConfig returns the REST client configuration for the given context of the certificate,
+// the current context if empty.
+func (c *Certificate) RestConfig(context string) (*RestConfig, error) {
+	config, err := c.SelectContext(KubeConfigOpts{Context: context})
+	if err != nil {
+		return nil, err
+	}
+	cluster, user := config.Clusters[0].Cluster, config.Users[0].User
+
+	result := &RestConfig{
+		Host:     cluster.Server,
+		Insecure: cluster.InsecureSkipTLSVerify,
+	}
+	if result.CAData, err = base64.StdEncoding.DecodeString(cluster.CertAuthorityData); err != nil {
+		return nil, fmt.Errorf("error decoding certificate authority data: %w", err)
+	}
+	if result.CertData, err = base64.StdEncoding.DecodeString(user.ClientCertData); err != nil {
+		return nil, fmt.Errorf("error decoding client certificate data: %w", err)
+	}
+	if result.KeyData, err = base64.StdEncoding.DecodeString(user.ClientKeyData); err != nil {
+		return nil, fmt.Errorf("error decoding client key data: %w", err)
+	}
+	if block, _ := pem.Decode(result.CertData); block != nil {
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			result.Expiry = cert.NotAfter
+		}
+	}
+	return result, nil
+}
+
+// TLSConfig returns the TLS configuration for the API server with the client certificate.
+func (c *RestConfig) TLSConfig() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(c.CertData, c.KeyData)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: c.Insecure,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if !c.Insecure && len(c.CAData) > 0 {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(c.CAData) {
+			return nil, fmt.Errorf("no valid certificates found in certificate authority data")
+		}
+	}
+	return config, nil
+}
+
+type RestConfigOpts struct {
+	// Context of the certificate to use, defaults to the current context of the certificate.
+	Context string
+	// Duration is the validity of the client certificates in days, see ExpirationOpts.
+	// Certificates with the default validity are requested if not set.
+	Duration int
+	// RefreshBefore is how long before the expiration the certificate is refreshed.
+	// Defaults to 10 minutes.
+	RefreshBefore time.Duration
+}
+
+// RestConfigSource provides the REST client configuration of a cluster, fetching a new client
+// certificate when the current one is about to expire. It is safe for concurrent use.
+type RestConfigSource struct {
+	client    *golangsdk.ServiceClient
+	clusterID string
+	opts      RestConfigOpts
+
+	mu     sync.Mutex
+	config *RestConfig
+}
+
+// NewRestConfigSource creates a RestConfigSource for the cluster.
+func NewRestConfigSource(client *golangsdk.ServiceClient, clusterID string, opts RestConfigOpts) *RestConfigSource {
+	if opts.RefreshBefore == 0 {
+		opts.RefreshBefore = 10 * time.Minute
+	}
+	return &RestConfigSource{client: client, clusterID: clusterID, opts: opts}
+}
+
+// Get returns the current configuration, requesting a new certificate if needed.
+func (s *RestConfigSource) Get() (*RestConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.config != nil && (s.config.Expiry.IsZero() || time.Now().Add(s.opts.RefreshBefore).Before(s.config.Expiry)) {
+		return s.config, nil
+	}
+
+	var result GetCertResult
+	if s.opts.Duration != 0 {
+		result = GetCertWithExpiration(s.client, s.clusterID, ExpirationOpts{Duration: s.opts.Duration})
+	} else {
+		result = GetCert(s.client, s.clusterID)
+	}
+	cert, err := result.Extract()
+	if err != nil {
+		return nil, err
+	}
+	config, err := cert.RestConfig(s.opts.Context)
+	if err != nil {
+		return nil, err
+	}
+	s.config = config
+	return config, nil
+}
+
+// GetClientCertificate returns the current client certificate. It can be used as
+// tls.Config.GetClientCertificate, so that connections always use a valid certificate.
+func (s *RestConfigSource) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	config, err := s.Get()
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(config.CertData, config.KeyData)
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// TLSConfig returns the TLS configuration for the API server, refreshing the client
+// certificate on new connections.
+func (s *RestConfigSource) TLSConfig() (*tls.Config, error) {
+	config, err := s.Get()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := config.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.Certificates = nil
+	tlsConfig.GetClientCertificate = s.GetClientCertificate
+	return tlsConfig, nil
+}
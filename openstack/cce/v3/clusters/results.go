@@ -147,59 +147,61 @@ type Endpoints struct {
 
 type Certificate struct {
 	// API type, fixed value Config
-	Kind string `json:"kind"`
+	Kind string `json:"kind" yaml:"kind"`
 	// API version, fixed value v1
-	ApiVersion string `json:"apiVersion"`
+	ApiVersion string `json:"apiVersion" yaml:"apiVersion"`
 	// Cluster list
-	Clusters []CertClusters `json:"clusters"`
+	Clusters []CertClusters `json:"clusters" yaml:"clusters"`
 	// User list
-	Users []CertUsers `json:"users"`
+	Users []CertUsers `json:"users" yaml:"users"`
 	// Context list
-	Contexts []CertContexts `json:"contexts"`
+	Contexts []CertContexts `json:"contexts" yaml:"contexts"`
 	// The current context
-	CurrentContext string `json:"current-context"`
+	CurrentContext string `json:"current-context" yaml:"current-context"`
 }
 
 type CertClusters struct {
 	// Cluster name
-	Name string `json:"name"`
+	Name string `json:"name" yaml:"name"`
 	// Cluster information
-	Cluster CertCluster `json:"cluster"`
+	Cluster CertCluster `json:"cluster" yaml:"cluster"`
 }
 
 type CertCluster struct {
 	// Server IP address
-	Server string `json:"server"`
+	Server string `json:"server" yaml:"server"`
 	// Certificate data
-	CertAuthorityData string `json:"certificate-authority-data"`
+	CertAuthorityData string `json:"certificate-authority-data,omitempty" yaml:"certificate-authority-data,omitempty"`
+	// Whether the server certificate is not verified
+	InsecureSkipTLSVerify bool `json:"insecure-skip-tls-verify,omitempty" yaml:"insecure-skip-tls-verify,omitempty"`
 }
 
 type CertUsers struct {
 	// User name
-	Name string `json:"name"`
+	Name string `json:"name" yaml:"name"`
 	// Cluster information
-	User CertUser `json:"user"`
+	User CertUser `json:"user" yaml:"user"`
 }
 
 type CertUser struct {
 	// Client certificate
-	ClientCertData string `json:"client-certificate-data"`
+	ClientCertData string `json:"client-certificate-data" yaml:"client-certificate-data"`
 	// Client key data
-	ClientKeyData string `json:"client-key-data"`
+	ClientKeyData string `json:"client-key-data" yaml:"client-key-data"`
 }
 
 type CertContexts struct {
 	// Context name
-	Name string `json:"name"`
+	Name string `json:"name" yaml:"name"`
 	// Context information
-	Context CertContext `json:"context"`
+	Context CertContext `json:"context" yaml:"context"`
 }
 
 type CertContext struct {
 	// Cluster name
-	Cluster string `json:"cluster"`
+	Cluster string `json:"cluster" yaml:"cluster"`
 	// User name
-	User string `json:"user"`
+	User string `json:"user" yaml:"user"`
 }
 
 // UnmarshalJSON helps to unmarshal Status fields into needed values.
@@ -235,3 +235,94 @@ var ListExpectedOTC = []clusters.Clusters{
 		},
 	},
 }
+
+// CertOutputTemplate is the certificate response, formatted with the base64 encoded
+// CA certificate, client certificate and client key.
+const CertOutputTemplate = `
+{
+    "kind": "Config",
+    "apiVersion": "v1",
+    "preferences": {},
+    "clusters": [
+        {
+            "name": "internalCluster",
+            "cluster": {
+                "server": "https://192.168.0.10:5443",
+                "certificate-authority-data": "%[1]s"
+            }
+        },
+        {
+            "name": "externalCluster",
+            "cluster": {
+                "server": "https://80.158.0.10:5443",
+                "insecure-skip-tls-verify": true
+            }
+        },
+        {
+            "name": "externalClusterTLSVerify",
+            "cluster": {
+                "server": "https://80.158.0.10:5443",
+                "certificate-authority-data": "%[1]s"
+            }
+        }
+    ],
+    "users": [
+        {
+            "name": "user",
+            "user": {
+                "client-certificate-data": "%[2]s",
+                "client-key-data": "%[3]s"
+            }
+        }
+    ],
+    "contexts": [
+        {
+            "name": "internal",
+            "context": {
+                "cluster": "internalCluster",
+                "user": "user"
+            }
+        },
+        {
+            "name": "external",
+            "context": {
+                "cluster": "externalCluster",
+                "user": "user"
+            }
+        },
+        {
+            "name": "externalTLSVerify",
+            "context": {
+                "cluster": "externalClusterTLSVerify",
+                "user": "user"
+            }
+        }
+    ],
+    "current-context": "external"
+}
+`
+
+// ExistingKubeConfig is a kubeconfig with another cluster and an outdated entry of the CCE cluster.
+const ExistingKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: other
+  cluster:
+    server: https://other:6443
+    extensions:
+    - name: custom
+- name: my-cluster
+  cluster:
+    server: https://old:5443
+users:
+- name: other
+  user:
+    exec:
+      command: get-token
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+current-context: other
+`
@@ -0,0 +1,79 @@
+package nodepools
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	// MaxScaleDownCooldownTime is the maximum scale-down cooldown time in minutes.
+	MaxScaleDownCooldownTime = 2147483647
+)
+
+// Validate checks the auto scaling parameters. The node counts of a disabled auto scaling
+// are not checked, as they are ignored.
+func (s AutoscalingSpec) Validate() error {
+	if s.ScaleDownCooldownTime < 0 || s.ScaleDownCooldownTime > MaxScaleDownCooldownTime {
+		return fmt.Errorf("scale-down cooldown time must be between 0 and %d minutes, got %d", MaxScaleDownCooldownTime, s.ScaleDownCooldownTime)
+	}
+	if s.Priority < 0 {
+		return fmt.Errorf("priority must not be negative, got %d", s.Priority)
+	}
+	if !s.Enable {
+		return nil
+	}
+	if s.MinNodeCount < 0 {
+		return fmt.Errorf("minimum node count must not be negative, got %d", s.MinNodeCount)
+	}
+	if s.MaxNodeCount < 1 {
+		return fmt.Errorf("maximum node count must be at least 1, got %d", s.MaxNodeCount)
+	}
+	if s.MaxNodeCount < s.MinNodeCount {
+		return fmt.Errorf("maximum node count %d is less than the minimum node count %d", s.MaxNodeCount, s.MinNodeCount)
+	}
+	return nil
+}
+
+// validateNodeCount checks the node count of the pool is valid with its auto scaling parameters.
+func validateNodeCount(nodeCount int, autoscaling AutoscalingSpec) error {
+	if err := autoscaling.Validate(); err != nil {
+		return err
+	}
+	if nodeCount < 0 {
+		return fmt.Errorf("node count must not be negative, got %d", nodeCount)
+	}
+	if autoscaling.Enable && nodeCount > autoscaling.MaxNodeCount {
+		return fmt.Errorf("node count %d exceeds the maximum node count %d", nodeCount, autoscaling.MaxNodeCount)
+	}
+	return nil
+}
+
+// Resize sets the expected node count of the node pool, keeping its other parameters. If auto scaling
+// is enabled and the count is below its minimum node count, the minimum is lowered to the count,
+// so that the pool can be scaled to zero without the autoscaler adding nodes back.
+func Resize(client *golangsdk.ServiceClient, clusterID, nodePoolID string, nodeCount int) (*NodePool, error) {
+	pool, err := Get(client, clusterID, nodePoolID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	autoscaling := pool.Spec.Autoscaling
+	if autoscaling.Enable && nodeCount < autoscaling.MinNodeCount {
+		autoscaling.MinNodeCount = nodeCount
+	}
+	opts := UpdateOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Metadata:   UpdateMetaData{Name: pool.Metadata.Name},
+		Spec: UpdateSpec{
+			NodeTemplate: UpdateNodeTemplate{
+				K8sTags: pool.Spec.NodeTemplate.K8sTags,
+				Taints:  pool.Spec.NodeTemplate.Taints,
+			},
+			InitialNodeCount: nodeCount,
+			Autoscaling:      autoscaling,
+		},
+	}
+	return Update(client, clusterID, nodePoolID, opts).Extract()
+}
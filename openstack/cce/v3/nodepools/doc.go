@@ -69,6 +69,17 @@ Example to Delete a node pool
 	if err != nil {
 		panic(err)
 	}
+
+Example to scale a node pool to zero
+
+	clusterID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	nodePoolID := "3c8e5957-649f-477b-9e5b-f1f75b21c011"
+
+	nodepool, err := nodepools.Resize(client, clusterID, nodePoolID, 0)
+	if err != nil {
+		panic(err)
+	}
 */
 
 package nodepools
@@ -129,6 +129,9 @@ type CreateOptsBuilder interface {
 
 // ToNodePoolCreateMap builds a create request body from CreateOpts.
 func (opts CreateOpts) ToNodePoolCreateMap() (map[string]interface{}, error) {
+	if err := validateNodeCount(opts.Spec.InitialNodeCount, opts.Spec.Autoscaling); err != nil {
+		return nil, err
+	}
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
@@ -203,6 +206,9 @@ type UpdateMetadata struct {
 
 // ToNodePoolUpdateMap builds an update body based on UpdateOpts.
 func (opts UpdateOpts) ToNodePoolUpdateMap() (map[string]interface{}, error) {
+	if err := validateNodeCount(opts.Spec.InitialNodeCount, opts.Spec.Autoscaling); err != nil {
+		return nil, err
+	}
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
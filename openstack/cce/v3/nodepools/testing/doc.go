@@ -0,0 +1,2 @@
+// nodepools unit tests
+package testing
@@ -0,0 +1,73 @@
+package testing
+
+const Output = `
+{
+    "kind": "NodePool",
+    "apiVersion": "v3",
+    "metadata": {
+        "name": "nodepool-1",
+        "uid": "eb6e2f47-5b0f-11ee-a4a0-0255ac100b05"
+    },
+    "spec": {
+        "type": "vm",
+        "nodeTemplate": {
+            "flavor": "s3.large.2",
+            "az": "eu-de-01",
+            "k8sTags": {
+                "app": "batch"
+            },
+            "taints": [
+                {
+                    "key": "dedicated",
+                    "value": "batch",
+                    "effect": "NoSchedule"
+                }
+            ]
+        },
+        "initialNodeCount": 3,
+        "autoscaling": {
+            "enable": true,
+            "minNodeCount": 2,
+            "maxNodeCount": 10,
+            "scaleDownCooldownTime": 15,
+            "priority": 1
+        }
+    },
+    "status": {
+        "phase": "",
+        "currentNode": 3
+    }
+}
+`
+
+const ResizeRequest = `
+{
+    "kind": "NodePool",
+    "apiversion": "v3",
+    "metadata": {
+        "name": "nodepool-1"
+    },
+    "spec": {
+        "nodeTemplate": {
+            "k8sTags": {
+                "app": "batch"
+            },
+            "taints": [
+                {
+                    "key": "dedicated",
+                    "value": "batch",
+                    "effect": "NoSchedule"
+                }
+            ]
+        },
+        "initialNodeCount": 0,
+        "autoscaling": {
+            "enable": true,
+            "minNodeCount": 0,
+            "maxNodeCount": 10,
+            "scaleDownCooldownTime": 15,
+            "priority": 1
+        }
+    }
+}
+`
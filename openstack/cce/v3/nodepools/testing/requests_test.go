@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/common"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodepools"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestAutoscalingValidate(t *testing.T) {
+	valid := []nodepools.AutoscalingSpec{
+		{},
+		{Enable: false, MinNodeCount: 5, MaxNodeCount: 1},
+		{Enable: true, MinNodeCount: 0, MaxNodeCount: 1},
+		{Enable: true, MinNodeCount: 3, MaxNodeCount: 3, ScaleDownCooldownTime: 60, Priority: 5},
+	}
+	for _, spec := range valid {
+		th.AssertNoErr(t, spec.Validate())
+	}
+
+	invalid := []nodepools.AutoscalingSpec{
+		{Enable: true, MinNodeCount: 0, MaxNodeCount: 0},
+		{Enable: true, MinNodeCount: -1, MaxNodeCount: 1},
+		{Enable: true, MinNodeCount: 4, MaxNodeCount: 3},
+		{ScaleDownCooldownTime: -1},
+		{Priority: -1},
+	}
+	for _, spec := range invalid {
+		th.AssertEquals(t, true, spec.Validate() != nil)
+	}
+
+	_, err := nodepools.UpdateOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Metadata:   nodepools.UpdateMetaData{Name: "nodepool-1"},
+		Spec: nodepools.UpdateSpec{
+			InitialNodeCount: 11,
+			Autoscaling:      nodepools.AutoscalingSpec{Enable: true, MaxNodeCount: 10},
+		},
+	}.ToNodePoolUpdateMap()
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodepools/eb6e2f47-5b0f-11ee-a4a0-0255ac100b05", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if r.Method == "PUT" {
+			th.TestJSONRequest(t, r, ResizeRequest)
+		} else {
+			th.TestMethod(t, r, "GET")
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, Output)
+	})
+
+	_, err := nodepools.Resize(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", "eb6e2f47-5b0f-11ee-a4a0-0255ac100b05", 0)
+	th.AssertNoErr(t, err)
+
+	_, err = nodepools.Resize(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", "eb6e2f47-5b0f-11ee-a4a0-0255ac100b05", 11)
+	th.AssertEquals(t, true, err != nil)
+}
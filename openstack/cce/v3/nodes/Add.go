@@ -0,0 +1,53 @@
+package nodes
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type AddOpts struct {
+	// API type, fixed value List
+	Kind string `json:"kind,omitempty"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// ECSs to accept into the cluster
+	NodeList []AddNode `json:"nodeList" required:"true"`
+}
+
+type AddNode struct {
+	// ID of the ECS
+	ServerID string `json:"serverID" required:"true"`
+	// Installation parameters of the node
+	Spec ReinstallSpec `json:"spec" required:"true"`
+}
+
+// Add accepts existing ECSs into the cluster as nodes. The OS of the ECSs is reinstalled.
+// It returns the ID of the job, see GetJobDetails.
+func Add(client *golangsdk.ServiceClient, clusterID string, opts AddOpts) (string, error) {
+	if opts.Kind == "" {
+		opts.Kind = "List"
+	}
+	if opts.ApiVersion == "" {
+		opts.ApiVersion = "v3"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/add
+	raw, err := client.Post(client.ServiceURL(rootPath, clusterID, resourcePath, "add"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var res struct {
+		JobID string `json:"jobid"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return res.JobID, err
+}
@@ -0,0 +1,60 @@
+package nodes
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type DrainOpts struct {
+	// API type, fixed value DrainNodesTask
+	Kind string `json:"kind,omitempty"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// Drain parameters
+	Spec DrainSpec `json:"spec" required:"true"`
+}
+
+type DrainSpec struct {
+	// IDs of the nodes to drain
+	Nodes []string `json:"nodes" required:"true"`
+	// Time to wait for the pods to be evicted, in seconds. 0 waits indefinitely
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// Whether to delete pods not managed by a controller
+	Force *bool `json:"force,omitempty"`
+	// Whether to delete pods using emptyDir volumes
+	DeleteEmptyDirData *bool `json:"deleteEmptyDirData,omitempty"`
+	// Whether to skip pods managed by DaemonSets
+	IgnoreDaemonSets *bool `json:"ignoreDaemonSets,omitempty"`
+	// Whether to delete the pods directly instead of evicting them, ignoring PodDisruptionBudgets
+	DisableEviction *bool `json:"disableEviction,omitempty"`
+	// Whether to only check the nodes can be drained
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// Drain cordons nodes of the cluster and evicts their pods.
+func Drain(client *golangsdk.ServiceClient, clusterID string, opts DrainOpts) (*NodesTask, error) {
+	if opts.Kind == "" {
+		opts.Kind = "DrainNodesTask"
+	}
+	if opts.ApiVersion == "" {
+		opts.ApiVersion = "v3"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/operation/drain
+	raw, err := client.Put(client.ServiceURL(rootPath, clusterID, resourcePath, "operation", "drain"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res NodesTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,67 @@
+package nodes
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type MigrateOpts struct {
+	// API type, fixed value MigrateNodesTask
+	Kind string `json:"kind,omitempty"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// Nodes to migrate
+	Spec MigrateSpec `json:"spec" required:"true"`
+}
+
+type MigrateSpec struct {
+	// Operating system of the nodes in the target cluster
+	Os string `json:"os" required:"true"`
+	// Login parameters, the current ones are kept if not set
+	Login *LoginSpec `json:"login,omitempty"`
+	// Extended parameters
+	ExtendParam *MigrateExtendParam `json:"extendParam,omitempty"`
+	// Nodes to migrate
+	Nodes []NodeRef `json:"nodes" required:"true"`
+}
+
+type MigrateExtendParam struct {
+	// Maximum number of pods on the node
+	MaxPods int `json:"maxPods,omitempty"`
+	// Available disk space of a single container, in GB
+	DockerBaseSize int `json:"DockerBaseSize,omitempty"`
+	// Base64 encoded script executed before the installation
+	PreInstall string `json:"alpha.cce/preInstall,omitempty"`
+	// Base64 encoded script executed after the installation
+	PostInstall string `json:"alpha.cce/postInstall,omitempty"`
+	// ID of the image used for the nodes
+	NodeImageID string `json:"alpha.cce/NodeImageID,omitempty"`
+}
+
+// Migrate moves nodes of the cluster to the target cluster. The OS of the nodes is reinstalled.
+func Migrate(client *golangsdk.ServiceClient, clusterID, targetClusterID string, opts MigrateOpts) (*NodesTask, error) {
+	if opts.Kind == "" {
+		opts.Kind = "MigrateNodesTask"
+	}
+	if opts.ApiVersion == "" {
+		opts.ApiVersion = "v3"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/operation/migrateto/{target_cluster_id}
+	raw, err := client.Put(client.ServiceURL(rootPath, clusterID, resourcePath, "operation", "migrateto", targetClusterID), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res NodesTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,100 @@
+package nodes
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// ReinstallSpec describes how the OS of a node is (re)installed when the node is reset or an
+// existing ECS is accepted into the cluster.
+type ReinstallSpec struct {
+	// Operating system of the node
+	Os string `json:"os" required:"true"`
+	// Node login parameters
+	Login LoginSpec `json:"login" required:"true"`
+	// Name of the node, the ECS name is kept if not set
+	Name string `json:"name,omitempty"`
+	// ECS configuration
+	ServerConfig *ReinstallServerConfig `json:"serverConfig,omitempty"`
+	// Disk configuration
+	VolumeConfig *ReinstallVolumeConfig `json:"volumeConfig,omitempty"`
+	// Container runtime configuration
+	RuntimeConfig *ReinstallRuntimeConfig `json:"runtimeConfig,omitempty"`
+	// Kubernetes node configuration
+	K8sOptions *ReinstallK8sOptions `json:"k8sOptions,omitempty"`
+	// Scripts executed during the installation
+	Lifecycle *NodeLifecycleConfig `json:"lifecycle,omitempty"`
+	// Extended parameters
+	ExtendParam *ReinstallExtendParam `json:"extendParam,omitempty"`
+}
+
+type ReinstallServerConfig struct {
+	// Tags of the ECS
+	UserTags []tags.ResourceTag `json:"userTags,omitempty"`
+	// System disk configuration
+	RootVolume *ReinstallVolumeSpec `json:"rootVolume,omitempty"`
+}
+
+type ReinstallVolumeSpec struct {
+	// ID of a private image
+	ImageID string `json:"imageID,omitempty"`
+	// ID of the KMS key encrypting the system disk
+	CmkID string `json:"cmkID,omitempty"`
+}
+
+type ReinstallVolumeConfig struct {
+	// Docker data disk configuration
+	LvmConfig string `json:"lvmConfig,omitempty"`
+	// Disk initialization management parameters
+	Storage *Storage `json:"storage,omitempty"`
+}
+
+type ReinstallRuntimeConfig struct {
+	// Available disk space of a single container, in GB
+	DockerBaseSize int `json:"dockerBaseSize,omitempty"`
+	// Container runtime
+	Runtime *RuntimeSpec `json:"runtime,omitempty"`
+}
+
+type ReinstallK8sOptions struct {
+	// Kubernetes labels of the node
+	Labels map[string]string `json:"labels,omitempty"`
+	// Kubernetes taints of the node
+	Taints []TaintSpec `json:"taints,omitempty"`
+	// Maximum number of pods on the node
+	MaxPods int `json:"maxPods,omitempty"`
+}
+
+type NodeLifecycleConfig struct {
+	// Base64 encoded script executed before the installation
+	PreInstall string `json:"preInstall,omitempty"`
+	// Base64 encoded script executed after the installation
+	PostInstall string `json:"postInstall,omitempty"`
+}
+
+type ReinstallExtendParam struct {
+	// ID of the image used for the node, alternative to ServerConfig.RootVolume.ImageID
+	NodeImageID string `json:"alpha.cce/NodeImageID,omitempty"`
+}
+
+// NodeRef references a node of the cluster.
+type NodeRef struct {
+	// Node ID
+	UID string `json:"uid" required:"true"`
+}
+
+// NodesTask is the asynchronous task returned by batch node operations.
+type NodesTask struct {
+	// API type of the task
+	Kind string `json:"kind"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion"`
+	// Status of the task
+	Status NodesTaskStatus `json:"status"`
+}
+
+type NodesTaskStatus struct {
+	// Status of the task
+	Phase string `json:"phase"`
+	// ID of the job executing the task, see GetJobDetails
+	JobID string `json:"jobID"`
+}
@@ -0,0 +1,51 @@
+package nodes
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type RemoveOpts struct {
+	// API type, fixed value RemoveNodesTask
+	Kind string `json:"kind,omitempty"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// Nodes to remove
+	Spec RemoveSpec `json:"spec" required:"true"`
+}
+
+type RemoveSpec struct {
+	// Login parameters of the ECSs after their OS is reinstalled
+	Login LoginSpec `json:"login" required:"true"`
+	// Nodes to remove
+	Nodes []NodeRef `json:"nodes" required:"true"`
+}
+
+// Remove removes nodes from the cluster without deleting the ECSs. The OS of the ECSs is
+// reinstalled, the data on the nodes is erased.
+func Remove(client *golangsdk.ServiceClient, clusterID string, opts RemoveOpts) (*NodesTask, error) {
+	if opts.Kind == "" {
+		opts.Kind = "RemoveNodesTask"
+	}
+	if opts.ApiVersion == "" {
+		opts.ApiVersion = "v3"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/operation/remove
+	raw, err := client.Put(client.ServiceURL(rootPath, clusterID, resourcePath, "operation", "remove"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res NodesTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,53 @@
+package nodes
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ResetOpts struct {
+	// API type, fixed value List
+	Kind string `json:"kind,omitempty"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// Nodes to reset
+	NodeList []ResetNode `json:"nodeList" required:"true"`
+}
+
+type ResetNode struct {
+	// Node ID
+	NodeID string `json:"nodeID" required:"true"`
+	// Reinstallation parameters of the node
+	Spec ReinstallSpec `json:"spec" required:"true"`
+}
+
+// Reset reinstalls the OS of nodes of the cluster. The data of the nodes is erased, the
+// nodes keep their IDs. It returns the ID of the job, see GetJobDetails.
+func Reset(client *golangsdk.ServiceClient, clusterID string, opts ResetOpts) (string, error) {
+	if opts.Kind == "" {
+		opts.Kind = "List"
+	}
+	if opts.ApiVersion == "" {
+		opts.ApiVersion = "v3"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/reset
+	raw, err := client.Post(client.ServiceURL(rootPath, clusterID, resourcePath, "reset"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var res struct {
+		JobID string `json:"jobid"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return res.JobID, err
+}
@@ -0,0 +1,45 @@
+package nodes
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type scheduleOpts struct {
+	Kind       string       `json:"kind"`
+	ApiVersion string       `json:"apiVersion"`
+	Spec       scheduleSpec `json:"spec"`
+}
+
+type scheduleSpec struct {
+	Nodes         []string `json:"nodes" required:"true"`
+	Unschedulable bool     `json:"unschedulable"`
+}
+
+// Cordon marks nodes of the cluster as unschedulable, running pods are kept.
+func Cordon(client *golangsdk.ServiceClient, clusterID string, nodeIDs ...string) error {
+	return schedule(client, clusterID, nodeIDs, true)
+}
+
+// Uncordon marks nodes of the cluster as schedulable.
+func Uncordon(client *golangsdk.ServiceClient, clusterID string, nodeIDs ...string) error {
+	return schedule(client, clusterID, nodeIDs, false)
+}
+
+func schedule(client *golangsdk.ServiceClient, clusterID string, nodeIDs []string, unschedulable bool) error {
+	b, err := build.RequestBody(scheduleOpts{
+		Kind:       "ScheduleNodesTask",
+		ApiVersion: "v3",
+		Spec:       scheduleSpec{Nodes: nodeIDs, Unschedulable: unschedulable},
+	}, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/operation/schedule
+	_, err = client.Put(client.ServiceURL(rootPath, clusterID, resourcePath, "operation", "schedule"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return err
+}
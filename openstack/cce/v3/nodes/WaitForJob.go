@@ -0,0 +1,24 @@
+package nodes
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForJob - wait until the job succeeds, a failed job results in an error
+func WaitForJob(client *golangsdk.ServiceClient, jobID string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		job, err := GetJobDetails(client, jobID).ExtractJob()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving job status: %w", err)
+		}
+		switch job.Status.Phase {
+		case "Success":
+			return true, nil
+		case "Failed":
+			return false, fmt.Errorf("job %s failed: %s", jobID, job.Status.Reason)
+		}
+		return false, nil
+	})
+}
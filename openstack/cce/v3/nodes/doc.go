@@ -57,6 +57,34 @@ Example to Delete a cluster
 	if err != nil {
 		panic(err)
 	}
+
+Example to drain a node and remove it from the cluster keeping the ECS
+
+	clusterID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	nodeID := "3c8e5957-649f-477b-9e5b-f1f75b21c011"
+
+	_, err := nodes.Drain(client, clusterID, nodes.DrainOpts{
+		Spec: nodes.DrainSpec{Nodes: []string{nodeID}, TimeoutSeconds: 600},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	task, err := nodes.Remove(client, clusterID, nodes.RemoveOpts{
+		Spec: nodes.RemoveSpec{
+			Login: nodes.LoginSpec{SshKey: "myKeypair"},
+			Nodes: []nodes.NodeRef{{UID: nodeID}},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = nodes.WaitForJob(client, task.Status.JobID, 1200)
+	if err != nil {
+		panic(err)
+	}
 */
 
 package nodes
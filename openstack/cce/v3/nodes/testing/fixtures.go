@@ -148,3 +148,76 @@ var ExpectedJob = &nodes.Job{
 		}},
 	},
 }
+
+const ResetRequest = `
+{
+    "kind": "List",
+    "apiVersion": "v3",
+    "nodeList": [
+        {
+            "nodeID": "cf4bc001-58f1-11e8-ad73-0255ac101926",
+            "spec": {
+                "os": "EulerOS 2.9",
+                "login": {
+                    "sshKey": "test-keypair",
+                    "userPassword": {
+                        "username": "",
+                        "password": ""
+                    }
+                },
+                "k8sOptions": {
+                    "labels": {
+                        "app": "test"
+                    },
+                    "maxPods": 110
+                }
+            }
+        }
+    ]
+}
+`
+
+const RemoveRequest = `
+{
+    "kind": "RemoveNodesTask",
+    "apiVersion": "v3",
+    "spec": {
+        "login": {
+            "sshKey": "test-keypair",
+            "userPassword": {
+                "username": "",
+                "password": ""
+            }
+        },
+        "nodes": [
+            {
+                "uid": "cf4bc001-58f1-11e8-ad73-0255ac101926"
+            }
+        ]
+    }
+}
+`
+
+const RemoveOutput = `
+{
+    "kind": "RemoveNodesTask",
+    "apiVersion": "v3",
+    "status": {
+        "phase": "Running",
+        "jobID": "73ce03fd-8b1b-11e8-8f9d-0255ac10193f"
+    }
+}
+`
+
+const DrainRequest = `
+{
+    "kind": "DrainNodesTask",
+    "apiVersion": "v3",
+    "spec": {
+        "nodes": ["cf4bc001-58f1-11e8-ad73-0255ac101926"],
+        "timeoutSeconds": 600,
+        "force": true,
+        "ignoreDaemonSets": true
+    }
+}
+`
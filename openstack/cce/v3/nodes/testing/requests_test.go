@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -274,3 +275,102 @@ func TestGetV3Job(t *testing.T) {
 	th.AssertDeepEquals(t, expected, actual)
 
 }
+
+func TestResetNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/reset", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ResetRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"jobid": "73ce03fd-8b1b-11e8-8f9d-0255ac10193f"}`)
+	})
+
+	jobID, err := nodes.Reset(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.ResetOpts{
+		NodeList: []nodes.ResetNode{
+			{
+				NodeID: "cf4bc001-58f1-11e8-ad73-0255ac101926",
+				Spec: nodes.ReinstallSpec{
+					Os:         "EulerOS 2.9",
+					Login:      nodes.LoginSpec{SshKey: "test-keypair"},
+					K8sOptions: &nodes.ReinstallK8sOptions{Labels: map[string]string{"app": "test"}, MaxPods: 110},
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "73ce03fd-8b1b-11e8-8f9d-0255ac10193f", jobID)
+
+	_, err = nodes.Reset(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.ResetOpts{})
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestRemoveNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/operation/remove", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, RemoveRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, RemoveOutput)
+	})
+
+	actual, err := nodes.Remove(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.RemoveOpts{
+		Spec: nodes.RemoveSpec{
+			Login: nodes.LoginSpec{SshKey: "test-keypair"},
+			Nodes: []nodes.NodeRef{{UID: "cf4bc001-58f1-11e8-ad73-0255ac101926"}},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "73ce03fd-8b1b-11e8-8f9d-0255ac10193f", actual.Status.JobID)
+}
+
+func TestDrainAndCordonNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/operation/drain", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, DrainRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"kind": "DrainNodesTask", "apiVersion": "v3", "status": {"phase": "Running"}}`)
+	})
+	var unschedulable []bool
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/operation/schedule", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		var body struct {
+			Spec struct {
+				Nodes         []string `json:"nodes"`
+				Unschedulable bool     `json:"unschedulable"`
+			} `json:"spec"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		th.AssertDeepEquals(t, []string{"cf4bc001-58f1-11e8-ad73-0255ac101926"}, body.Spec.Nodes)
+		unschedulable = append(unschedulable, body.Spec.Unschedulable)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	force := true
+	actual, err := nodes.Drain(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.DrainOpts{
+		Spec: nodes.DrainSpec{
+			Nodes:            []string{"cf4bc001-58f1-11e8-ad73-0255ac101926"},
+			TimeoutSeconds:   600,
+			Force:            &force,
+			IgnoreDaemonSets: &force,
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Running", actual.Status.Phase)
+
+	th.AssertNoErr(t, nodes.Cordon(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", "cf4bc001-58f1-11e8-ad73-0255ac101926"))
+	th.AssertNoErr(t, nodes.Uncordon(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", "cf4bc001-58f1-11e8-ad73-0255ac101926"))
+	th.AssertDeepEquals(t, []bool{true, false}, unschedulable)
+}
@@ -0,0 +1,15 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ContinueUpgrade resumes the paused upgrade of the cluster.
+func ContinueUpgrade(client *golangsdk.ServiceClient, clusterID string) error {
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/continue
+	_, err := client.Post(client.ServiceURL(clustersPath, clusterID, operationPath, upgradePath, "continue"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	return err
+}
@@ -0,0 +1,142 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetPreCheckTask returns the pre-check task of the cluster.
+func GetPreCheckTask(client *golangsdk.ServiceClient, clusterID, taskID string) (*PreCheckTask, error) {
+	// GET https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/precheck/tasks/{task_id}
+	raw, err := client.Get(client.ServiceURL(clustersPath, clusterID, operationPath, precheckPath, tasksPath, taskID), nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res PreCheckTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type PreCheckTask struct {
+	// API type, fixed value PreCheckTask
+	Kind string `json:"kind"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion"`
+	// Task metadata
+	Metadata TaskMetadata `json:"metadata"`
+	// Pre-check specification
+	Spec PreCheckSpec `json:"spec"`
+	// Pre-check status
+	Status PreCheckStatus `json:"status"`
+}
+
+type TaskMetadata struct {
+	// Task ID
+	UID string `json:"uid"`
+	// Creation time
+	CreationTimestamp string `json:"creationTimestamp"`
+	// Update time
+	UpdateTimestamp string `json:"updateTimestamp"`
+}
+
+type PreCheckStatus struct {
+	// Status of the check: Init, Running, Success, Failed or Error
+	Phase string `json:"phase"`
+	// Time the check result expires
+	ExpireTimeStamp string `json:"expireTimeStamp"`
+	// Check message
+	Message string `json:"message"`
+	// Results of the cluster checks
+	ClusterCheckStatus ClusterCheckStatus `json:"clusterCheckStatus"`
+	// Results of the node checks
+	NodeCheckStatus NodeCheckStatus `json:"nodeCheckStatus"`
+	// Results of the add-on checks
+	AddonCheckStatus AddonCheckStatus `json:"addonCheckStatus"`
+}
+
+type ClusterCheckStatus struct {
+	// Status of the cluster checks
+	Phase string `json:"phase"`
+	// Check items
+	ItemsStatus []CheckItemStatus `json:"itemsStatus"`
+}
+
+type NodeCheckStatus struct {
+	// Status of the node checks
+	Phase string `json:"phase"`
+	// Check items per node
+	NodeStageStatus []NodeStageStatus `json:"nodeStageStatus"`
+}
+
+type NodeStageStatus struct {
+	// Checked node
+	NodeInfo NodeInfo `json:"nodeInfo"`
+	// Check items
+	ItemsStatus []CheckItemStatus `json:"itemsStatus"`
+}
+
+type NodeInfo struct {
+	// Node ID
+	UID string `json:"uid"`
+	// Node name
+	Name string `json:"name"`
+	// Node status
+	Status string `json:"status"`
+	// Node pool ID
+	NodePoolID string `json:"nodePoolID"`
+	// Node type
+	NodeType string `json:"nodeType"`
+}
+
+type AddonCheckStatus struct {
+	// Status of the add-on checks
+	Phase string `json:"phase"`
+	// Check items per add-on
+	AddonStageStatus []AddonStageStatus `json:"addonStageStatus"`
+}
+
+type AddonStageStatus struct {
+	// Add-on template name
+	AddonTemplateName string `json:"addonTemplateName"`
+	// Check items
+	ItemsStatus []CheckItemStatus `json:"itemsStatus"`
+}
+
+type CheckItemStatus struct {
+	// Check item name
+	Name string `json:"name"`
+	// Check item kind
+	Kind string `json:"kind"`
+	// Check item group
+	Group string `json:"group"`
+	// Risk level: Info, Warning or Fatal
+	Level string `json:"level"`
+	// Status of the check item: Init, Running, Success, Failed or Error
+	Phase string `json:"phase"`
+	// Check message
+	Message string `json:"message"`
+}
+
+// FailedItems returns the failed check items of the cluster, its nodes and add-ons.
+func (s PreCheckStatus) FailedItems() []CheckItemStatus {
+	var result []CheckItemStatus
+	collect := func(items []CheckItemStatus) {
+		for _, item := range items {
+			if item.Phase == PhaseFailed || item.Phase == PhaseError {
+				result = append(result, item)
+			}
+		}
+	}
+	collect(s.ClusterCheckStatus.ItemsStatus)
+	for _, node := range s.NodeCheckStatus.NodeStageStatus {
+		collect(node.ItemsStatus)
+	}
+	for _, addon := range s.AddonCheckStatus.AddonStageStatus {
+		collect(addon.ItemsStatus)
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetUpgradeTask returns the upgrade task of the cluster.
+func GetUpgradeTask(client *golangsdk.ServiceClient, clusterID, taskID string) (*UpgradeTask, error) {
+	// GET https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/tasks/{task_id}
+	raw, err := client.Get(client.ServiceURL(clustersPath, clusterID, operationPath, upgradePath, tasksPath, taskID), nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res UpgradeTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type UpgradeTask struct {
+	// API type, fixed value UpgradeTask
+	Kind string `json:"kind"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion"`
+	// Task metadata
+	Metadata TaskMetadata `json:"metadata"`
+	// Task specification
+	Spec UpgradeTaskSpec `json:"spec"`
+	// Task status
+	Status UpgradeTaskStatus `json:"status"`
+}
+
+type UpgradeTaskSpec struct {
+	// Version of the cluster before the upgrade
+	Version string `json:"version"`
+	// Target version of the upgrade
+	TargetVersion string `json:"targetVersion"`
+	// Additional upgrade information
+	Items map[string]interface{} `json:"items"`
+}
+
+type UpgradeTaskStatus struct {
+	// Status of the upgrade: Init, Queuing, Running, Pause, Success or Failed
+	Phase string `json:"phase"`
+	// Upgrade progress in percent
+	Progress string `json:"progress"`
+	// Completion time of the upgrade
+	CompletionTime string `json:"completionTime"`
+}
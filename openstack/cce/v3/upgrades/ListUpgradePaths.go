@@ -0,0 +1,46 @@
+package upgrades
+
+import (
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// ListUpgradePaths returns the supported cluster upgrade paths.
+func ListUpgradePaths(client *golangsdk.ServiceClient) ([]UpgradePath, error) {
+	// GET https://{Endpoint}/api/v3/clusterupgradepaths
+	raw, err := client.Get(client.Endpoint+"api/v3/clusterupgradepaths", nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []UpgradePath
+	err = extract.IntoSlicePtr(raw.Body, &res, "upgradePaths")
+	return res, err
+}
+
+type UpgradePath struct {
+	// Platform version of the cluster, e.g. cce.10.0
+	PlatformVersion string `json:"platformVersion"`
+	// Kubernetes version of the cluster, e.g. v1.23
+	ClusterVersion string `json:"clusterVersion"`
+	// Versions the cluster can be upgraded to
+	TargetVersions []string `json:"targetVersions"`
+}
+
+// TargetVersions returns the versions a cluster running clusterVersion (e.g. v1.23.8-r0) can be
+// upgraded to.
+func TargetVersions(paths []UpgradePath, clusterVersion string) []string {
+	var result []string
+	for _, path := range paths {
+		if clusterVersion == path.ClusterVersion || strings.HasPrefix(clusterVersion, path.ClusterVersion+".") ||
+			strings.HasPrefix(clusterVersion, path.ClusterVersion+"-") {
+			result = append(result, path.TargetVersions...)
+		}
+	}
+	return result
+}
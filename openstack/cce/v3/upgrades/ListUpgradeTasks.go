@@ -0,0 +1,22 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// ListUpgradeTasks returns the upgrade tasks of the cluster.
+func ListUpgradeTasks(client *golangsdk.ServiceClient, clusterID string) ([]UpgradeTask, error) {
+	// GET https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/tasks
+	raw, err := client.Get(client.ServiceURL(clustersPath, clusterID, operationPath, upgradePath, tasksPath), nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []UpgradeTask
+	err = extract.IntoSlicePtr(raw.Body, &res, "items")
+	return res, err
+}
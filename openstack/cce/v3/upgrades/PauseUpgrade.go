@@ -0,0 +1,15 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// PauseUpgrade pauses the running upgrade of the cluster.
+func PauseUpgrade(client *golangsdk.ServiceClient, clusterID string) error {
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/pause
+	_, err := client.Post(client.ServiceURL(clustersPath, clusterID, operationPath, upgradePath, "pause"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	return err
+}
@@ -0,0 +1,54 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type PreCheckOpts struct {
+	// API type, fixed value PreCheckTask
+	Kind string `json:"kind,omitempty"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// Pre-check specification
+	Spec PreCheckSpec `json:"spec" required:"true"`
+}
+
+type PreCheckSpec struct {
+	// Upgrade the pre-check is executed for
+	ClusterUpgradeAction PreCheckUpgradeAction `json:"clusterUpgradeAction" required:"true"`
+}
+
+type PreCheckUpgradeAction struct {
+	// Target version of the upgrade, e.g. v1.25
+	TargetVersion string `json:"targetVersion" required:"true"`
+}
+
+// PreCheck starts a pre-upgrade check of the cluster. Use GetPreCheckTask or WaitForPreCheck
+// with the UID of the returned task to get the check results.
+func PreCheck(client *golangsdk.ServiceClient, clusterID string, opts PreCheckOpts) (*PreCheckTask, error) {
+	if opts.Kind == "" {
+		opts.Kind = "PreCheckTask"
+	}
+	if opts.ApiVersion == "" {
+		opts.ApiVersion = "v3"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/precheck
+	raw, err := client.Post(client.ServiceURL(clustersPath, clusterID, operationPath, precheckPath), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 201},
+		MoreHeaders: requestHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res PreCheckTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,15 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RetryUpgrade retries the failed upgrade of the cluster.
+func RetryUpgrade(client *golangsdk.ServiceClient, clusterID string) error {
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/retry
+	_, err := client.Post(client.ServiceURL(clustersPath, clusterID, operationPath, upgradePath, "retry"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestHeaders,
+	})
+	return err
+}
@@ -0,0 +1,112 @@
+package upgrades
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type UpgradeOpts struct {
+	// Upgrade metadata
+	Metadata UpgradeMetadata `json:"metadata"`
+	// Upgrade specification
+	Spec UpgradeSpec `json:"spec" required:"true"`
+}
+
+type UpgradeMetadata struct {
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// API type, fixed value UpgradeTask
+	Kind string `json:"kind,omitempty"`
+}
+
+type UpgradeSpec struct {
+	// Upgrade of the cluster
+	ClusterUpgradeAction ClusterUpgradeAction `json:"clusterUpgradeAction" required:"true"`
+}
+
+type ClusterUpgradeAction struct {
+	// Target version of the upgrade, e.g. v1.25
+	TargetVersion string `json:"targetVersion" required:"true"`
+	// Target version of the cluster platform
+	TargetPlatformVersion string `json:"targetPlatformVersion,omitempty"`
+	// Upgrade strategy, in-place rolling update if not set
+	Strategy *UpgradeStrategy `json:"strategy,omitempty"`
+	// Add-ons to upgrade together with the cluster
+	Addons []UpgradeAddon `json:"addons,omitempty"`
+	// Upgrade order of the nodes of node pools, by node pool ID.
+	// Use DefaultNodePoolID for the nodes not belonging to a node pool.
+	NodeOrder map[string][]NodePriority `json:"nodeOrder,omitempty"`
+	// Upgrade order of the node pools, by node pool ID. Pools with a higher priority are upgraded first.
+	NodePoolOrder map[string]int `json:"nodePoolOrder,omitempty"`
+}
+
+// DefaultNodePoolID identifies the nodes which don't belong to a node pool.
+const DefaultNodePoolID = "DefaultPool"
+
+type UpgradeStrategy struct {
+	// Strategy type, fixed value inPlaceRollingUpdate
+	Type string `json:"type" required:"true"`
+	// In-place rolling update configuration
+	InPlaceRollingUpdate *InPlaceRollingUpdate `json:"inPlaceRollingUpdate,omitempty"`
+}
+
+type InPlaceRollingUpdate struct {
+	// Number of nodes upgraded in one batch, from 1 to 40, defaults to 20
+	UserDefinedStep int `json:"userDefinedStep,omitempty"`
+}
+
+type UpgradeAddon struct {
+	// Add-on template name, e.g. coredns
+	AddonTemplateName string `json:"addonTemplateName" required:"true"`
+	// Operation, patch for upgrading an existing add-on
+	Operation string `json:"operation" required:"true"`
+	// Target version of the add-on
+	Version string `json:"version" required:"true"`
+	// Add-on configuration values
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+type NodePriority struct {
+	// Node selector
+	NodeSelector NodeSelector `json:"nodeSelector" required:"true"`
+	// Upgrade priority of the selected nodes, nodes with a higher priority are upgraded first
+	Priority int `json:"priority" required:"true"`
+}
+
+type NodeSelector struct {
+	// Label key, e.g. kubernetes.io/hostname
+	Key string `json:"key" required:"true"`
+	// Label values
+	Value []string `json:"value,omitempty"`
+	// Operator, fixed value In
+	Operator string `json:"operator" required:"true"`
+}
+
+// Upgrade starts the upgrade of the cluster and its node pools. Use GetUpgradeTask or
+// WaitForUpgrade with the UID of the returned task to track the progress.
+func Upgrade(client *golangsdk.ServiceClient, clusterID string, opts UpgradeOpts) (*UpgradeTask, error) {
+	if opts.Metadata.ApiVersion == "" {
+		opts.Metadata.ApiVersion = "v3"
+	}
+	if opts.Metadata.Kind == "" {
+		opts.Metadata.Kind = "UpgradeTask"
+	}
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade
+	raw, err := client.Post(client.ServiceURL(clustersPath, clusterID, operationPath, upgradePath), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 201},
+		MoreHeaders: requestHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res UpgradeTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,32 @@
+/*
+Package upgrades enables Kubernetes version upgrades of CCE clusters and their node pools.
+
+Example to list the versions a cluster can be upgraded to
+
+	cluster, err := clusters.Get(client, clusterID).Extract()
+	if err != nil {
+		panic(err)
+	}
+	paths, err := upgrades.ListUpgradePaths(client)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(upgrades.TargetVersions(paths, cluster.Spec.Version))
+
+Example to upgrade a cluster, upgrading the nodes of the node pool first
+
+	task, err := upgrades.Run(client, clusterID, upgrades.WorkflowOpts{
+		ClusterUpgradeAction: upgrades.ClusterUpgradeAction{
+			TargetVersion: "v1.25",
+			NodePoolOrder: map[string]int{nodePoolID: 100},
+		},
+		Timeout: 3600,
+		Report: func(status upgrades.StepStatus) {
+			log.Printf("%s %s: %s %s%%", status.Step, status.TaskID, status.Phase, status.Progress)
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+*/
+package upgrades
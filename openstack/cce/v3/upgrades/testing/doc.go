@@ -0,0 +1,2 @@
+// upgrades unit tests
+package testing
@@ -0,0 +1,186 @@
+package testing
+
+const clusterID = "daa97872-59d7-11e8-a787-0255ac101f54"
+
+const clusterOutput = `
+{
+    "kind": "Cluster",
+    "apiVersion": "v3",
+    "metadata": {
+        "name": "test-cluster",
+        "uid": "daa97872-59d7-11e8-a787-0255ac101f54"
+    },
+    "spec": {
+        "type": "VirtualMachine",
+        "flavor": "cce.s1.small",
+        "version": "v1.23.8-r0"
+    },
+    "status": {
+        "phase": "Available"
+    }
+}
+`
+
+const upgradePathsOutput = `
+{
+    "kind": "UpgradePath",
+    "apiVersion": "v3",
+    "metadata": {},
+    "upgradePaths": [
+        {
+            "platformVersion": "cce.10.0",
+            "clusterVersion": "v1.23",
+            "targetVersions": ["v1.25", "v1.27"]
+        },
+        {
+            "platformVersion": "cce.11.0",
+            "clusterVersion": "v1.25",
+            "targetVersions": ["v1.27"]
+        }
+    ]
+}
+`
+
+const preCheckRequest = `
+{
+    "kind": "PreCheckTask",
+    "apiVersion": "v3",
+    "spec": {
+        "clusterUpgradeAction": {
+            "targetVersion": "v1.25"
+        }
+    }
+}
+`
+
+const preCheckOutput = `
+{
+    "kind": "PreCheckTask",
+    "apiVersion": "v3",
+    "metadata": {
+        "uid": "0d2c1c1f-5c4c-4a1a-9b0e-7c2f0a7e1a01"
+    },
+    "spec": {
+        "clusterUpgradeAction": {
+            "targetVersion": "v1.25"
+        }
+    },
+    "status": {
+        "phase": "Init"
+    }
+}
+`
+
+const preCheckTaskOutput = `
+{
+    "kind": "PreCheckTask",
+    "apiVersion": "v3",
+    "metadata": {
+        "uid": "0d2c1c1f-5c4c-4a1a-9b0e-7c2f0a7e1a01",
+        "creationTimestamp": "2023-06-01 08:00:00.000 +0000 UTC"
+    },
+    "spec": {
+        "clusterUpgradeAction": {
+            "targetVersion": "v1.25"
+        }
+    },
+    "status": {
+        "phase": "%s",
+        "message": "",
+        "clusterCheckStatus": {
+            "phase": "Success",
+            "itemsStatus": [
+                {
+                    "name": "ClusterStatus",
+                    "kind": "Cluster",
+                    "group": "LimitCheck",
+                    "level": "Fatal",
+                    "phase": "Success"
+                }
+            ]
+        },
+        "nodeCheckStatus": {
+            "phase": "%s",
+            "nodeStageStatus": [
+                {
+                    "nodeInfo": {
+                        "uid": "c2b2a0b4-5b0f-11ee-a4a0-0255ac100b05",
+                        "name": "node-1",
+                        "status": "Active",
+                        "nodePoolID": "eb6e2f47-5b0f-11ee-a4a0-0255ac100b05"
+                    },
+                    "itemsStatus": [
+                        {
+                            "name": "NodeDiskSpace",
+                            "kind": "Node",
+                            "level": "Fatal",
+                            "phase": "%s",
+                            "message": "not enough disk space"
+                        }
+                    ]
+                }
+            ]
+        }
+    }
+}
+`
+
+const upgradeRequest = `
+{
+    "metadata": {
+        "apiVersion": "v3",
+        "kind": "UpgradeTask"
+    },
+    "spec": {
+        "clusterUpgradeAction": {
+            "targetVersion": "v1.25",
+            "strategy": {
+                "type": "inPlaceRollingUpdate",
+                "inPlaceRollingUpdate": {
+                    "userDefinedStep": 10
+                }
+            },
+            "nodePoolOrder": {
+                "eb6e2f47-5b0f-11ee-a4a0-0255ac100b05": 100
+            }
+        }
+    }
+}
+`
+
+const upgradeOutput = `
+{
+    "metadata": {
+        "uid": "7b0a7b5e-5b10-11ee-a4a0-0255ac100b05"
+    },
+    "spec": {
+        "version": "v1.23",
+        "targetVersion": "v1.25"
+    },
+    "status": {
+        "phase": "Init"
+    }
+}
+`
+
+const upgradeTaskOutput = `
+{
+    "apiVersion": "v3",
+    "kind": "UpgradeTask",
+    "metadata": {
+        "uid": "7b0a7b5e-5b10-11ee-a4a0-0255ac100b05",
+        "creationTimestamp": "2023-06-01 08:10:00.000 +0000 UTC",
+        "updateTimestamp": "2023-06-01 08:40:00.000 +0000 UTC"
+    },
+    "spec": {
+        "version": "v1.23",
+        "targetVersion": "v1.25",
+        "items": {}
+    },
+    "status": {
+        "phase": "Success",
+        "progress": "100",
+        "completionTime": "2023-06-01 08:40:00.000 +0000 UTC"
+    }
+}
+`
@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/common"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/upgrades"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const projectPath = "/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/" + clusterID
+
+func handle(t *testing.T, path, method, request, response string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if request != "" {
+			th.TestJSONRequest(t, r, request)
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+func TestListUpgradePaths(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handle(t, "/api/v3/clusterupgradepaths", "GET", "", upgradePathsOutput)
+
+	paths, err := upgrades.ListUpgradePaths(fake.ServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(paths))
+	th.AssertEquals(t, "cce.10.0", paths[0].PlatformVersion)
+	th.AssertDeepEquals(t, []string{"v1.25", "v1.27"}, upgrades.TargetVersions(paths, "v1.23.8-r0"))
+	th.AssertDeepEquals(t, []string{"v1.27"}, upgrades.TargetVersions(paths, "v1.25"))
+	th.AssertEquals(t, 0, len(upgrades.TargetVersions(paths, "v1.231")))
+}
+
+func TestPreCheckFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handle(t, projectPath+"/operation/precheck", "POST", preCheckRequest, preCheckOutput)
+	handle(t, projectPath+"/operation/precheck/tasks/0d2c1c1f-5c4c-4a1a-9b0e-7c2f0a7e1a01", "GET", "",
+		fmt.Sprintf(preCheckTaskOutput, "Failed", "Failed", "Failed"))
+
+	task, err := upgrades.PreCheck(fake.ServiceClient(), clusterID, upgrades.PreCheckOpts{
+		Spec: upgrades.PreCheckSpec{ClusterUpgradeAction: upgrades.PreCheckUpgradeAction{TargetVersion: "v1.25"}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "0d2c1c1f-5c4c-4a1a-9b0e-7c2f0a7e1a01", task.Metadata.UID)
+
+	var reported []upgrades.StepStatus
+	task, err = upgrades.WaitForPreCheck(fake.ServiceClient(), clusterID, task.Metadata.UID, 10, func(status upgrades.StepStatus) {
+		reported = append(reported, status)
+	})
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "NodeDiskSpace: not enough disk space"))
+	th.AssertEquals(t, "node-1", task.Status.NodeCheckStatus.NodeStageStatus[0].NodeInfo.Name)
+	th.AssertEquals(t, 1, len(task.Status.FailedItems()))
+	th.AssertEquals(t, 1, len(reported))
+	th.AssertEquals(t, upgrades.StepPreCheck, reported[0].Step)
+	th.AssertEquals(t, upgrades.PhaseFailed, reported[0].Phase)
+}
+
+func TestRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handle(t, projectPath, "GET", "", clusterOutput)
+	handle(t, "/api/v3/clusterupgradepaths", "GET", "", upgradePathsOutput)
+	handle(t, projectPath+"/operation/precheck", "POST", preCheckRequest, preCheckOutput)
+	handle(t, projectPath+"/operation/precheck/tasks/0d2c1c1f-5c4c-4a1a-9b0e-7c2f0a7e1a01", "GET", "",
+		fmt.Sprintf(preCheckTaskOutput, "Success", "Success", "Success"))
+	handle(t, projectPath+"/operation/upgrade", "POST", upgradeRequest, upgradeOutput)
+	handle(t, projectPath+"/operation/upgrade/tasks/7b0a7b5e-5b10-11ee-a4a0-0255ac100b05", "GET", "", upgradeTaskOutput)
+
+	var steps []upgrades.Step
+	task, err := upgrades.Run(fake.ServiceClient(), clusterID, upgrades.WorkflowOpts{
+		ClusterUpgradeAction: upgrades.ClusterUpgradeAction{
+			TargetVersion: "v1.25",
+			Strategy: &upgrades.UpgradeStrategy{
+				Type:                 "inPlaceRollingUpdate",
+				InPlaceRollingUpdate: &upgrades.InPlaceRollingUpdate{UserDefinedStep: 10},
+			},
+			NodePoolOrder: map[string]int{"eb6e2f47-5b0f-11ee-a4a0-0255ac100b05": 100},
+		},
+		Timeout: 10,
+		Report: func(status upgrades.StepStatus) {
+			steps = append(steps, status.Step)
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, upgrades.PhaseSuccess, task.Status.Phase)
+	th.AssertEquals(t, "100", task.Status.Progress)
+	th.AssertDeepEquals(t, []upgrades.Step{upgrades.StepPreCheck, upgrades.StepUpgrade}, steps)
+
+	_, err = upgrades.Run(fake.ServiceClient(), clusterID, upgrades.WorkflowOpts{
+		ClusterUpgradeAction: upgrades.ClusterUpgradeAction{TargetVersion: "v1.29"},
+	})
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestUpgradeControl(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handle(t, projectPath+"/operation/upgrade/tasks", "GET", "", `{"items": [`+upgradeTaskOutput+`]}`)
+	for _, action := range []string{"pause", "continue", "retry"} {
+		handle(t, projectPath+"/operation/upgrade/"+action, "POST", "", `{}`)
+	}
+
+	tasks, err := upgrades.ListUpgradeTasks(fake.ServiceClient(), clusterID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(tasks))
+	th.AssertEquals(t, "v1.25", tasks[0].Spec.TargetVersion)
+
+	th.AssertNoErr(t, upgrades.PauseUpgrade(fake.ServiceClient(), clusterID))
+	th.AssertNoErr(t, upgrades.ContinueUpgrade(fake.ServiceClient(), clusterID))
+	th.AssertNoErr(t, upgrades.RetryUpgrade(fake.ServiceClient(), clusterID))
+}
+
+func TestWaitForUpgradeFailed(t *testing.T) {
+	for _, phase := range []string{upgrades.PhaseFailed, upgrades.PhaseError} {
+		t.Run(phase, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+
+			output := strings.Replace(upgradeTaskOutput, `"phase": "Success",
+        "progress": "100"`, `"phase": "`+phase+`",
+        "progress": "40"`, 1)
+			handle(t, projectPath+"/operation/upgrade/tasks/7b0a7b5e-5b10-11ee-a4a0-0255ac100b05", "GET", "", output)
+
+			var reported []upgrades.StepStatus
+			task, err := upgrades.WaitForUpgrade(fake.ServiceClient(), clusterID, "7b0a7b5e-5b10-11ee-a4a0-0255ac100b05", 10, func(status upgrades.StepStatus) {
+				reported = append(reported, status)
+			})
+			th.AssertEquals(t, true, err != nil)
+			th.AssertEquals(t, true, strings.Contains(err.Error(), "failed at 40%"))
+			th.AssertEquals(t, phase, task.Status.Phase)
+			th.AssertEquals(t, 1, len(reported))
+			th.AssertEquals(t, phase, reported[0].Phase)
+		})
+	}
+}
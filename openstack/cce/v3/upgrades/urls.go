@@ -0,0 +1,11 @@
+package upgrades
+
+var requestHeaders = map[string]string{"Content-Type": "application/json"}
+
+const (
+	clustersPath  = "clusters"
+	operationPath = "operation"
+	precheckPath  = "precheck"
+	upgradePath   = "upgrade"
+	tasksPath     = "tasks"
+)
@@ -0,0 +1,172 @@
+package upgrades
+
+import (
+	"fmt"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/clusters"
+)
+
+const (
+	PhaseInit    = "Init"
+	PhaseQueuing = "Queuing"
+	PhaseRunning = "Running"
+	PhasePause   = "Pause"
+	PhaseSuccess = "Success"
+	PhaseFailed  = "Failed"
+	PhaseError   = "Error"
+)
+
+// Step is a step of the upgrade workflow.
+type Step string
+
+const (
+	StepPreCheck Step = "PreCheck"
+	StepUpgrade  Step = "Upgrade"
+)
+
+// StepStatus is reported by the waiters every time the status of a step changes.
+type StepStatus struct {
+	Step Step
+	// ID of the task executing the step
+	TaskID string
+	// Status of the task
+	Phase string
+	// Progress in percent, set for StepUpgrade only
+	Progress string
+	// Status message
+	Message string
+}
+
+// WaitForPreCheck waits until the pre-check task finishes. The pre-check fails if any
+// check item fails; the failed items are listed in the error.
+func WaitForPreCheck(client *golangsdk.ServiceClient, clusterID, taskID string, timeout int, report func(StepStatus)) (*PreCheckTask, error) {
+	var res *PreCheckTask
+	var last StepStatus
+
+	err := golangsdk.WaitFor(timeout, func() (bool, error) {
+		cur, err := GetPreCheckTask(client, clusterID, taskID)
+		if err != nil {
+			return false, err
+		}
+		res = cur
+
+		status := StepStatus{Step: StepPreCheck, TaskID: taskID, Phase: cur.Status.Phase, Message: cur.Status.Message}
+		if report != nil && status != last {
+			report(status)
+		}
+		last = status
+
+		switch cur.Status.Phase {
+		case PhaseSuccess:
+			return true, nil
+		case PhaseFailed, PhaseError:
+			var failed []string
+			for _, item := range cur.Status.FailedItems() {
+				failed = append(failed, fmt.Sprintf("%s: %s", item.Name, item.Message))
+			}
+			return false, fmt.Errorf("pre-check %s of cluster %s failed: %s %s", taskID, clusterID, cur.Status.Message, strings.Join(failed, "; "))
+		}
+		return false, nil
+	})
+
+	return res, err
+}
+
+// WaitForUpgrade waits until the upgrade task succeeds or fails. A paused upgrade is
+// waited for until it is continued.
+func WaitForUpgrade(client *golangsdk.ServiceClient, clusterID, taskID string, timeout int, report func(StepStatus)) (*UpgradeTask, error) {
+	var res *UpgradeTask
+	var last StepStatus
+
+	err := golangsdk.WaitFor(timeout, func() (bool, error) {
+		cur, err := GetUpgradeTask(client, clusterID, taskID)
+		if err != nil {
+			return false, err
+		}
+		res = cur
+
+		status := StepStatus{Step: StepUpgrade, TaskID: taskID, Phase: cur.Status.Phase, Progress: cur.Status.Progress}
+		if report != nil && status != last {
+			report(status)
+		}
+		last = status
+
+		switch cur.Status.Phase {
+		case PhaseSuccess:
+			return true, nil
+		case PhaseFailed, PhaseError:
+			return false, fmt.Errorf("upgrade %s of cluster %s failed at %s%%", taskID, clusterID, cur.Status.Progress)
+		}
+		return false, nil
+	})
+
+	return res, err
+}
+
+const defaultStepTimeout = 2 * 60 * 60
+
+type WorkflowOpts struct {
+	// Upgrade of the cluster, TargetVersion is required
+	ClusterUpgradeAction ClusterUpgradeAction
+	// SkipPreCheck starts the upgrade without a pre-check
+	SkipPreCheck bool
+	// Timeout of every step in seconds, defaults to 2 hours
+	Timeout int
+	// Report is called every time the status of a step changes
+	Report func(StepStatus)
+}
+
+// Run upgrades the cluster: it verifies the target version is a valid upgrade path of the cluster,
+// runs the pre-check, starts the upgrade and waits for it to finish.
+func Run(client *golangsdk.ServiceClient, clusterID string, opts WorkflowOpts) (*UpgradeTask, error) {
+	targetVersion := opts.ClusterUpgradeAction.TargetVersion
+	if targetVersion == "" {
+		return nil, fmt.Errorf("target version is required")
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = defaultStepTimeout
+	}
+
+	cluster, err := clusters.Get(client, clusterID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	paths, err := ListUpgradePaths(client)
+	if err != nil {
+		return nil, err
+	}
+	targets := TargetVersions(paths, cluster.Spec.Version)
+	found := false
+	for _, target := range targets {
+		if target == targetVersion {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("cluster %s can't be upgraded from %s to %s, supported versions: %s",
+			clusterID, cluster.Spec.Version, targetVersion, strings.Join(targets, ", "))
+	}
+
+	if !opts.SkipPreCheck {
+		task, err := PreCheck(client, clusterID, PreCheckOpts{
+			Spec: PreCheckSpec{ClusterUpgradeAction: PreCheckUpgradeAction{TargetVersion: targetVersion}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := WaitForPreCheck(client, clusterID, task.Metadata.UID, opts.Timeout, opts.Report); err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := Upgrade(client, clusterID, UpgradeOpts{
+		Spec: UpgradeSpec{ClusterUpgradeAction: opts.ClusterUpgradeAction},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return WaitForUpgrade(client, clusterID, task.Metadata.UID, opts.Timeout, opts.Report)
+}
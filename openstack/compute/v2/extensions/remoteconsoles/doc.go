@@ -0,0 +1,34 @@
+/*
+Package remoteconsoles provides the ability to create remote consoles (noVNC,
+serial and others) for servers of the OpenStack Compute service.
+
+Example to Create a Remote Console
+
+	computeClient.Microversion = "2.6"
+
+	createOpts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	remoteConsole, err := remoteconsoles.Create(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Console URL: %s\n", remoteConsole.URL)
+
+Example to Get a Console Without Microversions
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	remoteConsole, err := remoteconsoles.GetVNCConsole(computeClient, serverID, remoteconsoles.ConsoleTypeNoVNC).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Console output (serial log) of a server is available through
+servers.ShowConsoleOutput.
+*/
+package remoteconsoles
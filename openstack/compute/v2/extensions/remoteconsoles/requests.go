@@ -0,0 +1,101 @@
+package remoteconsoles
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ConsoleProtocol represents valid remote console protocol.
+// It can be used to create a remote console with one of the pre-defined protocol.
+type ConsoleProtocol string
+
+const (
+	// ConsoleProtocolVNC represents the VNC console protocol.
+	ConsoleProtocolVNC ConsoleProtocol = "vnc"
+
+	// ConsoleProtocolSPICE represents the SPICE console protocol.
+	ConsoleProtocolSPICE ConsoleProtocol = "spice"
+
+	// ConsoleProtocolRDP represents the RDP console protocol.
+	ConsoleProtocolRDP ConsoleProtocol = "rdp"
+
+	// ConsoleProtocolSerial represents the Serial console protocol.
+	ConsoleProtocolSerial ConsoleProtocol = "serial"
+)
+
+// ConsoleType represents valid remote console type.
+// It can be used to create a remote console with one of the pre-defined type.
+type ConsoleType string
+
+const (
+	// ConsoleTypeNoVNC represents the VNC console type.
+	ConsoleTypeNoVNC ConsoleType = "novnc"
+
+	// ConsoleTypeXVPVNC represents the XVP VNC console type.
+	ConsoleTypeXVPVNC ConsoleType = "xvpvnc"
+
+	// ConsoleTypeRDPHTML5 represents the RDP HTML5 console type.
+	ConsoleTypeRDPHTML5 ConsoleType = "rdp-html5"
+
+	// ConsoleTypeSPICEHTML5 represents the SPICE HTML5 console type.
+	ConsoleTypeSPICEHTML5 ConsoleType = "spice-html5"
+
+	// ConsoleTypeSerial represents the Serial console type.
+	ConsoleTypeSerial ConsoleType = "serial"
+)
+
+// CreateOptsBuilder allows to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToRemoteConsoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters to the Create request.
+type CreateOpts struct {
+	// Protocol specifies the protocol of a new remote console.
+	Protocol ConsoleProtocol `json:"protocol" required:"true"`
+
+	// Type specifies the type of a new remote console.
+	Type ConsoleType `json:"type" required:"true"`
+}
+
+// ToRemoteConsoleCreateMap builds a request body from the CreateOpts.
+func (opts CreateOpts) ToRemoteConsoleCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "remote_console")
+}
+
+// Create requests the creation of a new remote console on the specified server.
+// It requires the compute microversion 2.6 or later.
+func Create(client *golangsdk.ServiceClient, serverID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRemoteConsoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetVNCConsole requests a VNC console of the given type using the
+// `os-getVNCConsole` server action, which doesn't require microversions.
+func GetVNCConsole(client *golangsdk.ServiceClient, serverID string, consoleType ConsoleType) (r GetResult) {
+	return getConsole(client, serverID, "os-getVNCConsole", consoleType)
+}
+
+// GetSerialConsole requests a serial console using the `os-getSerialConsole`
+// server action, which doesn't require microversions.
+func GetSerialConsole(client *golangsdk.ServiceClient, serverID string) (r GetResult) {
+	return getConsole(client, serverID, "os-getSerialConsole", ConsoleTypeSerial)
+}
+
+func getConsole(client *golangsdk.ServiceClient, serverID, action string, consoleType ConsoleType) (r GetResult) {
+	b := map[string]interface{}{
+		action: map[string]interface{}{
+			"type": consoleType,
+		},
+	}
+	_, r.Err = client.Post(actionURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,46 @@
+package remoteconsoles
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// RemoteConsole represents the Compute service remote console object.
+type RemoteConsole struct {
+	// Protocol contains remote console protocol.
+	// It is empty for consoles received through server actions.
+	Protocol string `json:"protocol"`
+
+	// Type contains remote console type.
+	Type string `json:"type"`
+
+	// URL can be used to connect to the remote console.
+	URL string `json:"url"`
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a RemoteConsole.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets any CreateResult as a RemoteConsole.
+func (r CreateResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		RemoteConsole *RemoteConsole `json:"remote_console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RemoteConsole, err
+}
+
+// GetResult represents the result of a console server action. Call its
+// Extract method to interpret it as a RemoteConsole.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets any GetResult as a RemoteConsole.
+func (r GetResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		Console *RemoteConsole `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Console, err
+}
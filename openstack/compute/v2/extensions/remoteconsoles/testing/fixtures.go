@@ -0,0 +1,41 @@
+package testing
+
+// RemoteConsoleCreateRequest represents a request to create a remote console.
+const RemoteConsoleCreateRequest = `
+{
+    "remote_console": {
+        "protocol": "vnc",
+        "type": "novnc"
+    }
+}
+`
+
+// RemoteConsoleCreateResult represents a raw server response to the RemoteConsoleCreateRequest.
+const RemoteConsoleCreateResult = `
+{
+    "remote_console": {
+        "protocol": "vnc",
+        "type": "novnc",
+        "url": "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677"
+    }
+}
+`
+
+// SerialConsoleRequest represents a request to get a serial console.
+const SerialConsoleRequest = `
+{
+    "os-getSerialConsole": {
+        "type": "serial"
+    }
+}
+`
+
+// SerialConsoleResult represents a raw server response to the SerialConsoleRequest.
+const SerialConsoleResult = `
+{
+    "console": {
+        "type": "serial",
+        "url": "ws://127.0.0.1:6083/?token=f9906a48-b71e-4f18-baca-c987da3ebdb3"
+    }
+}
+`
@@ -0,0 +1,62 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/remoteconsoles"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/remote-consoles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.6")
+		th.TestJSONRequest(t, r, RemoteConsoleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, RemoteConsoleCreateResult)
+	})
+
+	client := fake.ServiceClient()
+	client.Type = "compute"
+	client.Microversion = "2.6"
+
+	s, err := remoteconsoles.Create(client, "b16ba811-199d-4ffd-8839-ba96c1185a67", remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "vnc", s.Protocol)
+	th.AssertEquals(t, "novnc", s.Type)
+	th.AssertEquals(t, "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677", s.URL)
+}
+
+func TestGetSerialConsole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, SerialConsoleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, SerialConsoleResult)
+	})
+
+	s, err := remoteconsoles.GetSerialConsole(fake.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "serial", s.Type)
+	th.AssertEquals(t, "ws://127.0.0.1:6083/?token=f9906a48-b71e-4f18-baca-c987da3ebdb3", s.URL)
+}
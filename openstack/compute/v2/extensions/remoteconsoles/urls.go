@@ -0,0 +1,17 @@
+package remoteconsoles
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath = "servers"
+
+	resourcePath = "remote-consoles"
+)
+
+func createURL(client *golangsdk.ServiceClient, serverID string) string {
+	return client.ServiceURL(rootPath, serverID, resourcePath)
+}
+
+func actionURL(client *golangsdk.ServiceClient, serverID string) string {
+	return client.ServiceURL(rootPath, serverID, "action")
+}
@@ -111,5 +111,18 @@ Example to Snapshot a Server
 	if err != nil {
 		panic(err)
 	}
+
+Example to Get the Last Lines of the Console Output of a Server
+
+	outputOpts := servers.ShowConsoleOutputOpts{
+		Length: 100,
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	output, err := servers.ShowConsoleOutput(computeClient, serverID, outputOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package servers
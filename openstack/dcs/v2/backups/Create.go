@@ -0,0 +1,41 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type CreateOpts struct {
+	// DCS instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Description of DCS instance backup.
+	Remark string `json:"remark,omitempty"`
+	// Format of the backup file. Options:
+	// aof
+	// rdb
+	// This parameter is valid for Redis 3.0 and later instances only.
+	BackupFormat string `json:"backup_format,omitempty"`
+}
+
+// Create starts a manual backup of the instance and returns the backup ID.
+func Create(client *golangsdk.ServiceClient, opts CreateOpts) (string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST /v2/{project_id}/instances/{instance_id}/backups
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "backups"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var res struct {
+		BackupId string `json:"backup_id"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return res.BackupId, err
+}
@@ -0,0 +1,14 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Delete deletes the backup file of the instance.
+func Delete(client *golangsdk.ServiceClient, instanceId string, backupId string) (err error) {
+	// DELETE /v2/{project_id}/instances/{instance_id}/backups/{backup_id}
+	_, err = client.Delete(client.ServiceURL("instances", instanceId, "backups", backupId), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
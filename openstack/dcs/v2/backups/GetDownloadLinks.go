@@ -0,0 +1,52 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type DownloadLinksOpts struct {
+	// DCS instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// ID of the backup record
+	BackupId string `json:"-" required:"true"`
+	// URL validity period in seconds. The value ranges from 300 to 86400.
+	Expiration int `json:"expiration" required:"true"`
+}
+
+// GetDownloadLinks returns temporary URLs for downloading the backup files.
+func GetDownloadLinks(client *golangsdk.ServiceClient, opts DownloadLinksOpts) (*DownloadLinks, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v2/{project_id}/instances/{instance_id}/backups/{backup_id}/links
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "backups", opts.BackupId, "links"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res DownloadLinks
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type DownloadLinks struct {
+	// Path of the backup files in the OBS bucket.
+	FilePath string `json:"file_path"`
+	// Name of the OBS bucket.
+	BucketName string `json:"bucket_name"`
+	// Download URLs of the backup files.
+	Links []Link `json:"links"`
+}
+
+type Link struct {
+	// Name of the backup file.
+	FileName string `json:"file_name"`
+	// Download URL of the backup file.
+	Link string `json:"link"`
+}
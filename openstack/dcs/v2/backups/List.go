@@ -0,0 +1,83 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListOpts struct {
+	// Offset of the first record to query. By default, this parameter is set to 0.
+	Offset int `q:"offset"`
+	// Number of records displayed on each page. By default, 10 records are displayed on each page.
+	Limit int `q:"limit"`
+	// Start time of the period to be queried. Format: yyyyMMddHHmmss, for example, 20170718235959.
+	BeginTime string `q:"begin_time"`
+	// End time of the period to be queried. Format: yyyyMMddHHmmss, for example, 20170718235959.
+	EndTime string `q:"end_time"`
+}
+
+// List returns the backup records of the instance.
+func List(client *golangsdk.ServiceClient, instanceId string, opts ListOpts) (*ListResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, "backups").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2/{project_id}/instances/{instance_id}/backups
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	// Number of obtained backup records.
+	TotalNum int `json:"total_num"`
+	// Array of the backup records.
+	Backups []Backup `json:"backup_record_response"`
+}
+
+type Backup struct {
+	// ID of the backup record
+	BackupId string `json:"backup_id"`
+	// Time segment in which DCS instance backup was performed
+	Period string `json:"period"`
+	// Name of the backup record
+	BackupName string `json:"backup_name"`
+	// DCS instance ID
+	InstanceId string `json:"instance_id"`
+	// Size of the backup file. Unit: byte.
+	Size int64 `json:"size"`
+	// Backup type. Options:
+	// manual: manual backup
+	// auto: automatic backup
+	BackupType string `json:"backup_type"`
+	// Time at which the backup task is created
+	CreatedAt string `json:"created_at"`
+	// Time at which DCS instance backup is completed
+	UpdatedAt string `json:"updated_at"`
+	// Backup progress
+	Progress string `json:"progress"`
+	// Error code returned if DCS instance backup fails.
+	ErrorCode string `json:"error_code"`
+	// Description of DCS instance backup
+	Remark string `json:"remark"`
+	// Backup status. Options:
+	// waiting: DCS instance backup is waiting to begin.
+	// backuping: DCS instance backup is in progress.
+	// succeed: DCS instance backup succeeded.
+	// failed: DCS instance backup failed.
+	// expired: The backup file expires.
+	// deleted: The backup file has been deleted manually.
+	Status string `json:"status"`
+	// An indicator of whether restoration is supported. Options: TRUE or FALSE.
+	IsSupportRestore string `json:"is_support_restore"`
+	// Time at which the backup starts.
+	ExecutionAt string `json:"execution_at"`
+	// Backup format.
+	BackupFormat string `json:"backup_format"`
+}
@@ -0,0 +1,60 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// ListRestores returns the restoration records of the instance.
+func ListRestores(client *golangsdk.ServiceClient, instanceId string, opts ListOpts) (*ListRestoresResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, "restores").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2/{project_id}/instances/{instance_id}/restores
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListRestoresResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListRestoresResponse struct {
+	// Number of obtained restoration records.
+	TotalNum int `json:"total_num"`
+	// Array of the restoration records.
+	Restores []Restoration `json:"restore_record_response"`
+}
+
+type Restoration struct {
+	// ID of the restoration record
+	RestoreId string `json:"restore_id"`
+	// Name of the restoration record
+	RestoreName string `json:"restore_name"`
+	// ID of the backup record
+	BackupId string `json:"backup_id"`
+	// Name of the backup record
+	BackupName string `json:"backup_name"`
+	// Description of DCS instance backup
+	BackupRemark string `json:"backup_remark"`
+	// Description of DCS instance restoration
+	RestoreRemark string `json:"restore_remark"`
+	// Time at which the restoration task is created
+	CreatedAt string `json:"created_at"`
+	// Time at which DCS instance restoration completed
+	UpdatedAt string `json:"updated_at"`
+	// Restoration progress
+	Progress string `json:"progress"`
+	// Error code returned if DCS instance restoration fails.
+	ErrorCode string `json:"error_code"`
+	// Restoration status
+	// waiting: DCS instance restoration is waiting to begin.
+	// restoring: DCS instance restoration is in progress.
+	// succeed: DCS instance restoration succeeded.
+	// failed: DCS instance restoration failed.
+	Status string `json:"status"`
+}
@@ -0,0 +1,38 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type RestoreOpts struct {
+	// DCS instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// ID of the backup record
+	BackupId string `json:"backup_id" required:"true"`
+	// Description of DCS instance restoration
+	Remark string `json:"remark,omitempty"`
+}
+
+// Restore restores the backup to the instance and returns the restoration ID.
+func Restore(client *golangsdk.ServiceClient, opts RestoreOpts) (string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	// POST /v2/{project_id}/instances/{instance_id}/restores
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "restores"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var res struct {
+		RestoreId string `json:"restore_id"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return res.RestoreId, err
+}
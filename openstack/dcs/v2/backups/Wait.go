@@ -0,0 +1,84 @@
+package backups
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForBackup waits up to timeout seconds until the backup succeeds.
+func WaitForBackup(client *golangsdk.ServiceClient, instanceId, backupId string, timeout int) error {
+	return golangsdk.WaitFor(timeout, func() (bool, error) {
+		backup, err := findBackup(client, instanceId, backupId)
+		if err != nil {
+			return false, err
+		}
+
+		switch backup.Status {
+		case "succeed":
+			return true, nil
+		case "failed", "expired", "deleted":
+			return false, fmt.Errorf("backup %s of instance %s is %s: %s", backupId, instanceId, backup.Status, backup.ErrorCode)
+		}
+
+		time.Sleep(5 * time.Second)
+		return false, nil
+	})
+}
+
+// WaitForRestore waits up to timeout seconds until the restoration succeeds.
+func WaitForRestore(client *golangsdk.ServiceClient, instanceId, restoreId string, timeout int) error {
+	return golangsdk.WaitFor(timeout, func() (bool, error) {
+		restore, err := findRestore(client, instanceId, restoreId)
+		if err != nil {
+			return false, err
+		}
+
+		switch restore.Status {
+		case "succeed":
+			return true, nil
+		case "failed":
+			return false, fmt.Errorf("restoration %s of instance %s failed: %s", restoreId, instanceId, restore.ErrorCode)
+		}
+
+		time.Sleep(5 * time.Second)
+		return false, nil
+	})
+}
+
+const pageSize = 50
+
+func findBackup(client *golangsdk.ServiceClient, instanceId, backupId string) (*Backup, error) {
+	for offset := 0; ; offset += pageSize {
+		res, err := List(client, instanceId, ListOpts{Offset: offset, Limit: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, backup := range res.Backups {
+			if backup.BackupId == backupId {
+				return &backup, nil
+			}
+		}
+		if len(res.Backups) < pageSize || offset+len(res.Backups) >= res.TotalNum {
+			return nil, fmt.Errorf("backup %s of instance %s not found", backupId, instanceId)
+		}
+	}
+}
+
+func findRestore(client *golangsdk.ServiceClient, instanceId, restoreId string) (*Restoration, error) {
+	for offset := 0; ; offset += pageSize {
+		res, err := ListRestores(client, instanceId, ListOpts{Offset: offset, Limit: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, restore := range res.Restores {
+			if restore.RestoreId == restoreId {
+				return &restore, nil
+			}
+		}
+		if len(res.Restores) < pageSize || offset+len(res.Restores) >= res.TotalNum {
+			return nil, fmt.Errorf("restoration %s of instance %s not found", restoreId, instanceId)
+		}
+	}
+}
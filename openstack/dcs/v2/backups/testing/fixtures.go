@@ -0,0 +1,77 @@
+package testing
+
+const createRequest = `
+{
+    "remark": "before upgrade",
+    "backup_format": "rdb"
+}
+`
+
+const listResponse = `
+{
+    "total_num": 1,
+    "backup_record_response": [
+        {
+            "backup_id": "backup-id",
+            "period": "",
+            "backup_name": "backup_20230601080000",
+            "instance_id": "instance-id",
+            "size": 1024,
+            "backup_type": "manual",
+            "created_at": "2023-06-01T08:00:00.000Z",
+            "updated_at": "2023-06-01T08:01:00.000Z",
+            "progress": "100.00",
+            "error_code": "",
+            "remark": "before upgrade",
+            "status": "%s",
+            "is_support_restore": "TRUE",
+            "execution_at": "2023-06-01T08:00:00.000Z",
+            "backup_format": "rdb"
+        }
+    ]
+}
+`
+
+const restoreRequest = `
+{
+    "backup_id": "backup-id"
+}
+`
+
+const listRestoresResponse = `
+{
+    "total_num": 1,
+    "restore_record_response": [
+        {
+            "restore_id": "restore-id",
+            "restore_name": "restore_20230601090000",
+            "backup_id": "backup-id",
+            "backup_name": "backup_20230601080000",
+            "created_at": "2023-06-01T09:00:00.000Z",
+            "updated_at": "2023-06-01T09:01:00.000Z",
+            "progress": "100.00",
+            "error_code": "",
+            "status": "succeed"
+        }
+    ]
+}
+`
+
+const linksRequest = `
+{
+    "expiration": 3600
+}
+`
+
+const linksResponse = `
+{
+    "file_path": "instance-id/backup-id",
+    "bucket_name": "dcs-backups",
+    "links": [
+        {
+            "file_name": "backup.rdb",
+            "link": "https://dcs-backups.obs.example.com/instance-id/backup-id/backup.rdb?Signature=abc"
+        }
+    ]
+}
+`
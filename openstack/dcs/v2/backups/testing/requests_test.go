@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/backups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		if r.Method == "POST" {
+			th.TestJSONRequest(t, r, createRequest)
+			_, _ = fmt.Fprint(w, `{"backup_id": "backup-id"}`)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		_, _ = fmt.Fprintf(w, listResponse, "succeed")
+	})
+	th.Mux.HandleFunc("/instances/instance-id/backups/backup-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	backupId, err := backups.Create(fake.ServiceClient(), backups.CreateOpts{
+		InstanceId:   "instance-id",
+		Remark:       "before upgrade",
+		BackupFormat: "rdb",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "backup-id", backupId)
+
+	th.AssertNoErr(t, backups.WaitForBackup(fake.ServiceClient(), "instance-id", backupId, 10))
+
+	list, err := backups.List(fake.ServiceClient(), "instance-id", backups.ListOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(1024), list.Backups[0].Size)
+	th.AssertEquals(t, "rdb", list.Backups[0].BackupFormat)
+
+	th.AssertNoErr(t, backups.Delete(fake.ServiceClient(), "instance-id", backupId))
+}
+
+func TestWaitForFailedBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/backups", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, listResponse, "failed")
+	})
+
+	err := backups.WaitForBackup(fake.ServiceClient(), "instance-id", "backup-id", 10)
+	th.AssertEquals(t, "backup backup-id of instance instance-id is failed: ", err.Error())
+
+	err = backups.WaitForBackup(fake.ServiceClient(), "instance-id", "other-id", 10)
+	th.AssertEquals(t, "backup other-id of instance instance-id not found", err.Error())
+}
+
+func TestRestore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/restores", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if r.Method == "POST" {
+			th.TestJSONRequest(t, r, restoreRequest)
+			_, _ = fmt.Fprint(w, `{"restore_id": "restore-id"}`)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, listRestoresResponse)
+	})
+
+	restoreId, err := backups.Restore(fake.ServiceClient(), backups.RestoreOpts{InstanceId: "instance-id", BackupId: "backup-id"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "restore-id", restoreId)
+	th.AssertNoErr(t, backups.WaitForRestore(fake.ServiceClient(), "instance-id", restoreId, 10))
+}
+
+func TestGetDownloadLinks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/backups/backup-id/links", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, linksRequest)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, linksResponse)
+	})
+
+	res, err := backups.GetDownloadLinks(fake.ServiceClient(), backups.DownloadLinksOpts{
+		InstanceId: "instance-id",
+		BackupId:   "backup-id",
+		Expiration: 3600,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "dcs-backups", res.BucketName)
+	th.AssertEquals(t, "backup.rdb", res.Links[0].FileName)
+}
@@ -0,0 +1,44 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	ScanStatusWaiting = "waiting"
+	ScanStatusRunning = "running"
+	ScanStatusSuccess = "success"
+	ScanStatusFailed  = "failed"
+)
+
+// CreateBigKeyScan starts a big key scan of the instance, see WaitForBigKeyScan.
+func CreateBigKeyScan(client *golangsdk.ServiceClient, instanceId string) (*ScanTask, error) {
+	// POST /v2/{project_id}/instances/{instance_id}/bigkey-task
+	raw, err := client.Post(client.ServiceURL("instances", instanceId, "bigkey-task"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return extractScanTask(err, raw)
+}
+
+type ScanTask struct {
+	// ID of the scan task.
+	Id string `json:"id"`
+	// DCS instance ID.
+	InstanceId string `json:"instance_id"`
+	// Status of the scan task. Options:
+	// waiting: The task is waiting to be processed.
+	// running: The task is being processed.
+	// success: The task succeeded.
+	// failed: The task failed.
+	Status string `json:"status"`
+	// Scan mode. Options:
+	// manual: manual scan
+	// auto: automatic scan
+	ScanType string `json:"scan_type"`
+	// Time when the scan task is created.
+	CreatedAt string `json:"created_at"`
+	// Time when the scan task is started.
+	StartedAt string `json:"started_at"`
+	// Time when the scan task is finished.
+	FinishedAt string `json:"finished_at"`
+}
@@ -0,0 +1,27 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// CreateExpiredKeyScan starts a scan of the instance releasing expired keys, see WaitForExpiredKeyScan.
+func CreateExpiredKeyScan(client *golangsdk.ServiceClient, instanceId string) (*ExpiredKeyScan, error) {
+	// POST /v2/{project_id}/instances/{instance_id}/auto-expire/task
+	raw, err := client.Post(client.ServiceURL("instances", instanceId, "auto-expire", "task"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ExpiredKeyScan
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ExpiredKeyScan struct {
+	ScanTask
+	// Number of expired keys scanned and released.
+	Num int `json:"num"`
+}
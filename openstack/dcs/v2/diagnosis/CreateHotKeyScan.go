@@ -0,0 +1,15 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateHotKeyScan starts a hot key scan of the instance, see WaitForHotKeyScan.
+// Hot key scans require the maxmemory-policy of the instance to be allkeys-lfu or volatile-lfu.
+func CreateHotKeyScan(client *golangsdk.ServiceClient, instanceId string) (*ScanTask, error) {
+	// POST /v2/{project_id}/instances/{instance_id}/hotkey-task
+	raw, err := client.Post(client.ServiceURL("instances", instanceId, "hotkey-task"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return extractScanTask(err, raw)
+}
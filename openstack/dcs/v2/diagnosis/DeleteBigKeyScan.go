@@ -0,0 +1,14 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// DeleteBigKeyScan deletes the big key scan task.
+func DeleteBigKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string) (err error) {
+	// DELETE /v2/{project_id}/instances/{instance_id}/bigkey-task/{bigkey_id}
+	_, err = client.Delete(client.ServiceURL("instances", instanceId, "bigkey-task", taskId), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
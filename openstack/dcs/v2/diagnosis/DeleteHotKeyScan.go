@@ -0,0 +1,14 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// DeleteHotKeyScan deletes the hot key scan task.
+func DeleteHotKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string) (err error) {
+	// DELETE /v2/{project_id}/instances/{instance_id}/hotkey-task/{hotkey_id}
+	_, err = client.Delete(client.ServiceURL("instances", instanceId, "hotkey-task", taskId), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
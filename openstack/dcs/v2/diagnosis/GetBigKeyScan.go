@@ -0,0 +1,42 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetBigKeyScan returns the big key scan task with the keys found.
+func GetBigKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string) (*BigKeyScan, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/bigkey-task/{bigkey_id}
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "bigkey-task", taskId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res BigKeyScan
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type BigKeyScan struct {
+	ScanTask
+	// Number of big keys found.
+	Num int `json:"num"`
+	// Big keys found.
+	Keys []BigKey `json:"keys"`
+}
+
+type BigKey struct {
+	// Key name.
+	Name string `json:"name"`
+	// Key type: string, list, set, zset or hash.
+	Type string `json:"type"`
+	// Shard where the key is located. Set for cluster instances only, in the "ip:port" format.
+	Shard string `json:"shard"`
+	// Database where the key is located.
+	DB int `json:"db"`
+	// Key value size: the length of a string, or the number of elements of the other types.
+	Size int64 `json:"size"`
+	// Unit of the size: byte for strings, count for the other types.
+	Unit string `json:"unit"`
+}
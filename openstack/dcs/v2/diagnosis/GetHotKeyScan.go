@@ -0,0 +1,44 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetHotKeyScan returns the hot key scan task with the keys found.
+func GetHotKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string) (*HotKeyScan, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/hotkey-task/{hotkey_id}
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "hotkey-task", taskId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res HotKeyScan
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type HotKeyScan struct {
+	ScanTask
+	// Number of hot keys found.
+	Num int `json:"num"`
+	// Hot keys found.
+	Keys []HotKey `json:"keys"`
+}
+
+type HotKey struct {
+	// Key name.
+	Name string `json:"name"`
+	// Key type: string, list, set, zset or hash.
+	Type string `json:"type"`
+	// Shard where the key is located. Set for cluster instances only, in the "ip:port" format.
+	Shard string `json:"shard"`
+	// Database where the key is located.
+	DB int `json:"db"`
+	// Key value size: the length of a string, or the number of elements of the other types.
+	Size int64 `json:"size"`
+	// Unit of the size: byte for strings, count for the other types.
+	Unit string `json:"unit"`
+	// Access frequency of the key: the logarithmic access counter of the LFU policy, up to 255.
+	Freq int `json:"freq"`
+}
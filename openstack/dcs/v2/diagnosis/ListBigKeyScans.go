@@ -0,0 +1,44 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListScansOpts struct {
+	// Offset of the first record to query. By default, this parameter is set to 0.
+	Offset int `q:"offset"`
+	// Number of records to return. By default, 10 records are returned.
+	Limit int `q:"limit"`
+	// Status of the scan tasks to return: waiting, running, success or failed.
+	Status string `q:"status"`
+}
+
+// ListBigKeyScans returns the big key scan tasks of the instance.
+func ListBigKeyScans(client *golangsdk.ServiceClient, instanceId string, opts ListScansOpts) (*ListScansResponse, error) {
+	return listScans(client, instanceId, "bigkey-tasks", opts)
+}
+
+func listScans(client *golangsdk.ServiceClient, instanceId, resource string, opts ListScansOpts) (*ListScansResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, resource).WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2/{project_id}/instances/{instance_id}/{resource}
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListScansResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListScansResponse struct {
+	// Total number of scan tasks.
+	Count int `json:"count"`
+	// Scan tasks.
+	Records []ScanTask `json:"records"`
+}
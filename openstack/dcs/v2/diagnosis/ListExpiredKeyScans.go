@@ -0,0 +1,31 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// ListExpiredKeyScans returns the expired key scan records of the instance.
+func ListExpiredKeyScans(client *golangsdk.ServiceClient, instanceId string, opts ListScansOpts) (*ListExpiredKeyScansResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, "auto-expire", "histories").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2/{project_id}/instances/{instance_id}/auto-expire/histories
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListExpiredKeyScansResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListExpiredKeyScansResponse struct {
+	// Total number of scan records.
+	Count int `json:"count"`
+	// Scan records.
+	Records []ExpiredKeyScan `json:"records"`
+}
@@ -0,0 +1,10 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ListHotKeyScans returns the hot key scan tasks of the instance.
+func ListHotKeyScans(client *golangsdk.ServiceClient, instanceId string, opts ListScansOpts) (*ListScansResponse, error) {
+	return listScans(client, instanceId, "hotkey-tasks", opts)
+}
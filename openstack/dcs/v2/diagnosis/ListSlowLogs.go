@@ -0,0 +1,59 @@
+package diagnosis
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListSlowLogsOpts struct {
+	// Offset of the first record to query. By default, this parameter is set to 0.
+	Offset int `q:"offset"`
+	// Number of records to return. By default, 10 records are returned.
+	Limit int `q:"limit"`
+	// Sorting field: start_time or duration.
+	SortKey string `q:"sort_key"`
+	// Sorting order: desc or asc.
+	SortDir string `q:"sort_dir"`
+	// Start time of the query in the "yyyy-MM-ddTHH:mm:ss.SSSZ" format, for example, 2023-06-01T08:00:00.000Z.
+	StartTime string `q:"start_time,required"`
+	// End time of the query in the "yyyy-MM-ddTHH:mm:ss.SSSZ" format, for example, 2023-06-01T09:00:00.000Z.
+	EndTime string `q:"end_time,required"`
+}
+
+// ListSlowLogs returns the slow queries of the instance.
+func ListSlowLogs(client *golangsdk.ServiceClient, instanceId string, opts ListSlowLogsOpts) (*ListSlowLogsResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, "slowlog").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2/{project_id}/instances/{instance_id}/slowlog
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListSlowLogsResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListSlowLogsResponse struct {
+	// Total number of slow queries.
+	Count int `json:"count"`
+	// Slow queries.
+	SlowLogs []SlowLog `json:"slowlogs"`
+}
+
+type SlowLog struct {
+	// Unique ID of the slow query.
+	Id int64 `json:"id"`
+	// Slow command.
+	Command string `json:"command"`
+	// Time when the command was executed, in the "yyyy-MM-ddTHH:mm:ss.SSSZ" format.
+	StartTime string `json:"start_time"`
+	// Execution duration of the command in milliseconds.
+	Duration string `json:"duration"`
+	// Shard where the command was executed. Set for cluster instances only, in the "ip:port" format.
+	ShardName string `json:"shard_name"`
+}
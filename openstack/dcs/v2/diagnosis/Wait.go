@@ -0,0 +1,78 @@
+package diagnosis
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForBigKeyScan waits up to timeout seconds until the big key scan succeeds and returns its result.
+func WaitForBigKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string, timeout int) (*BigKeyScan, error) {
+	var res *BigKeyScan
+	err := waitForScan(timeout, func() (*ScanTask, error) {
+		cur, err := GetBigKeyScan(client, instanceId, taskId)
+		if err != nil {
+			return nil, err
+		}
+		res = cur
+		return &cur.ScanTask, nil
+	})
+	return res, err
+}
+
+// WaitForHotKeyScan waits up to timeout seconds until the hot key scan succeeds and returns its result.
+func WaitForHotKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string, timeout int) (*HotKeyScan, error) {
+	var res *HotKeyScan
+	err := waitForScan(timeout, func() (*ScanTask, error) {
+		cur, err := GetHotKeyScan(client, instanceId, taskId)
+		if err != nil {
+			return nil, err
+		}
+		res = cur
+		return &cur.ScanTask, nil
+	})
+	return res, err
+}
+
+// WaitForExpiredKeyScan waits up to timeout seconds until the expired key scan succeeds and returns its result.
+func WaitForExpiredKeyScan(client *golangsdk.ServiceClient, instanceId, taskId string, timeout int) (*ExpiredKeyScan, error) {
+	var res *ExpiredKeyScan
+	err := waitForScan(timeout, func() (*ScanTask, error) {
+		for offset := 0; ; offset += 100 {
+			page, err := ListExpiredKeyScans(client, instanceId, ListScansOpts{Offset: offset, Limit: 100})
+			if err != nil {
+				return nil, err
+			}
+			for i := range page.Records {
+				if page.Records[i].Id == taskId {
+					res = &page.Records[i]
+					return &res.ScanTask, nil
+				}
+			}
+			if len(page.Records) == 0 || offset+len(page.Records) >= page.Count {
+				return nil, fmt.Errorf("expired key scan %s of instance %s not found", taskId, instanceId)
+			}
+		}
+	})
+	return res, err
+}
+
+func waitForScan(timeout int, get func() (*ScanTask, error)) error {
+	return golangsdk.WaitFor(timeout, func() (bool, error) {
+		task, err := get()
+		if err != nil {
+			return false, err
+		}
+
+		switch task.Status {
+		case ScanStatusSuccess:
+			return true, nil
+		case ScanStatusFailed:
+			return false, fmt.Errorf("scan %s of instance %s failed", task.Id, task.InstanceId)
+		}
+
+		time.Sleep(2 * time.Second)
+		return false, nil
+	})
+}
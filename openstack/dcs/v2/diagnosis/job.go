@@ -0,0 +1,17 @@
+package diagnosis
+
+import (
+	"net/http"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+func extractScanTask(err error, raw *http.Response) (*ScanTask, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var res ScanTask
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
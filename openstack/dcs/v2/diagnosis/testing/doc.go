@@ -0,0 +1,2 @@
+// diagnosis unit tests
+package testing
@@ -0,0 +1,122 @@
+package testing
+
+const createScanResponse = `
+{
+    "id": "task-id",
+    "instance_id": "instance-id",
+    "status": "waiting",
+    "scan_type": "manual",
+    "created_at": "2023-06-01T08:00:00.000Z"
+}
+`
+
+const bigKeyScanResponse = `
+{
+    "id": "task-id",
+    "instance_id": "instance-id",
+    "status": "%s",
+    "scan_type": "manual",
+    "created_at": "2023-06-01T08:00:00.000Z",
+    "started_at": "2023-06-01T08:00:01.000Z",
+    "finished_at": "2023-06-01T08:00:05.000Z",
+    "num": 2,
+    "keys": [
+        {
+            "name": "session:1",
+            "type": "string",
+            "shard": "192.168.0.10:6379",
+            "db": 0,
+            "size": 10485760,
+            "unit": "byte"
+        },
+        {
+            "name": "queue",
+            "type": "list",
+            "shard": "192.168.0.11:6379",
+            "db": 1,
+            "size": 50000,
+            "unit": "count"
+        }
+    ]
+}
+`
+
+const hotKeyScanResponse = `
+{
+    "id": "task-id",
+    "instance_id": "instance-id",
+    "status": "success",
+    "scan_type": "auto",
+    "created_at": "2023-06-01T08:00:00.000Z",
+    "started_at": "2023-06-01T08:00:01.000Z",
+    "finished_at": "2023-06-01T08:00:05.000Z",
+    "num": 1,
+    "keys": [
+        {
+            "name": "counter",
+            "type": "string",
+            "shard": "",
+            "db": 0,
+            "size": 8,
+            "unit": "byte",
+            "freq": 255
+        }
+    ]
+}
+`
+
+const listScansResponse = `
+{
+    "count": 1,
+    "records": [
+        {
+            "id": "task-id",
+            "instance_id": "instance-id",
+            "status": "success",
+            "scan_type": "manual",
+            "created_at": "2023-06-01T08:00:00.000Z",
+            "started_at": "2023-06-01T08:00:01.000Z",
+            "finished_at": "2023-06-01T08:00:05.000Z"
+        }
+    ]
+}
+`
+
+const expiredKeyScansResponse = `
+{
+    "count": 2,
+    "records": [
+        {
+            "id": "other-id",
+            "instance_id": "instance-id",
+            "status": "success",
+            "scan_type": "auto",
+            "num": 3,
+            "created_at": "2023-05-31T08:00:00.000Z"
+        },
+        {
+            "id": "task-id",
+            "instance_id": "instance-id",
+            "status": "success",
+            "scan_type": "manual",
+            "num": 42,
+            "created_at": "2023-06-01T08:00:00.000Z"
+        }
+    ]
+}
+`
+
+const slowLogsResponse = `
+{
+    "count": 1,
+    "slowlogs": [
+        {
+            "id": 17,
+            "command": "KEYS *",
+            "start_time": "2023-06-01T08:10:00.000Z",
+            "duration": "25",
+            "shard_name": "192.168.0.10:6379"
+        }
+    ]
+}
+`
@@ -0,0 +1,152 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/diagnosis"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestBigKeyScan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/bigkey-task", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, createScanResponse)
+	})
+	var requests int
+	th.Mux.HandleFunc("/instances/instance-id/bigkey-task/task-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		requests++
+		status := diagnosis.ScanStatusRunning
+		if requests > 1 {
+			status = diagnosis.ScanStatusSuccess
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, bigKeyScanResponse, status)
+	})
+
+	task, err := diagnosis.CreateBigKeyScan(fake.ServiceClient(), "instance-id")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "task-id", task.Id)
+	th.AssertEquals(t, diagnosis.ScanStatusWaiting, task.Status)
+
+	res, err := diagnosis.WaitForBigKeyScan(fake.ServiceClient(), "instance-id", task.Id, 30)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, requests)
+	th.AssertEquals(t, 2, res.Num)
+	th.AssertDeepEquals(t, diagnosis.BigKey{
+		Name:  "queue",
+		Type:  "list",
+		Shard: "192.168.0.11:6379",
+		DB:    1,
+		Size:  50000,
+		Unit:  "count",
+	}, res.Keys[1])
+
+	th.AssertNoErr(t, diagnosis.DeleteBigKeyScan(fake.ServiceClient(), "instance-id", task.Id))
+}
+
+func TestWaitForFailedScan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/bigkey-task/task-id", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, bigKeyScanResponse, diagnosis.ScanStatusFailed)
+	})
+
+	_, err := diagnosis.WaitForBigKeyScan(fake.ServiceClient(), "instance-id", "task-id", 30)
+	th.AssertEquals(t, "scan task-id of instance instance-id failed", err.Error())
+}
+
+func TestHotKeyScan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/hotkey-tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"status": "success", "limit": "10"})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, listScansResponse)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/hotkey-task/task-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, hotKeyScanResponse)
+	})
+
+	list, err := diagnosis.ListHotKeyScans(fake.ServiceClient(), "instance-id", diagnosis.ListScansOpts{Status: "success", Limit: 10})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.Count)
+
+	res, err := diagnosis.WaitForHotKeyScan(fake.ServiceClient(), "instance-id", list.Records[0].Id, 30)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "auto", res.ScanType)
+	th.AssertEquals(t, 255, res.Keys[0].Freq)
+}
+
+func TestExpiredKeyScan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/auto-expire/task", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, createScanResponse)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/auto-expire/histories", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, expiredKeyScansResponse)
+	})
+
+	task, err := diagnosis.CreateExpiredKeyScan(fake.ServiceClient(), "instance-id")
+	th.AssertNoErr(t, err)
+
+	res, err := diagnosis.WaitForExpiredKeyScan(fake.ServiceClient(), "instance-id", task.Id, 30)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 42, res.Num)
+}
+
+func TestListSlowLogs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/slowlog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"sort_key":   "duration",
+			"sort_dir":   "desc",
+			"start_time": "2023-06-01T08:00:00.000Z",
+			"end_time":   "2023-06-01T09:00:00.000Z",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, slowLogsResponse)
+	})
+
+	res, err := diagnosis.ListSlowLogs(fake.ServiceClient(), "instance-id", diagnosis.ListSlowLogsOpts{
+		SortKey:   "duration",
+		SortDir:   "desc",
+		StartTime: "2023-06-01T08:00:00.000Z",
+		EndTime:   "2023-06-01T09:00:00.000Z",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []diagnosis.SlowLog{{
+		Id:        17,
+		Command:   "KEYS *",
+		StartTime: "2023-06-01T08:10:00.000Z",
+		Duration:  "25",
+		ShardName: "192.168.0.10:6379",
+	}}, res.SlowLogs)
+}
@@ -0,0 +1,25 @@
+package migration
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type DeleteOpts struct {
+	// IDs of the migration tasks to delete.
+	TaskIdList []string `json:"task_id_list" required:"true"`
+}
+
+// Delete deletes the data migration tasks.
+func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// DELETE /v2/{project_id}/migration-tasks
+	_, err = client.DeleteWithBody(client.ServiceURL("migration-tasks"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
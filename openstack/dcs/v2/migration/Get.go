@@ -0,0 +1,55 @@
+package migration
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// Get returns the details of the data migration task.
+func Get(client *golangsdk.ServiceClient, taskId string) (*MigrationTaskDetails, error) {
+	// GET /v2/{project_id}/migration-task/{task_id}
+	raw, err := client.Get(client.ServiceURL("migration-task", taskId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res MigrationTaskDetails
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type MigrationTaskDetails struct {
+	// ID of the migration task.
+	TaskId string `json:"task_id"`
+	// Name of the migration task.
+	TaskName string `json:"task_name"`
+	// Description of the migration task.
+	Description string `json:"description"`
+	// Migration task status, see CreateResponse.Status.
+	Status string `json:"status"`
+	// Mode of the migration: backupfile_import or online_migration.
+	MigrationType string `json:"migration_type"`
+	// Type of the migration: full_amount_migration or incremental_migration.
+	MigrationMethod string `json:"migration_method"`
+	// Backup files imported by the task.
+	BackupFiles BackupFilesBody `json:"backup_files"`
+	// Type of the network for communication between the source and destination Redis: vpc or vpn.
+	NetworkType string `json:"network_type"`
+	// Source Redis information.
+	SourceInstance InstanceInfo `json:"source_instance"`
+	// Destination Redis information.
+	TargetInstance InstanceInfo `json:"target_instance"`
+	// Time when the migration task is created.
+	CreatedAt string `json:"created_at"`
+	// Time when the migration task is updated.
+	UpdatedAt string `json:"updated_at"`
+}
+
+type InstanceInfo struct {
+	// Redis address.
+	Addrs string `json:"addrs"`
+	// DCS instance ID.
+	Id string `json:"id"`
+	// DCS instance name.
+	Name string `json:"name"`
+}
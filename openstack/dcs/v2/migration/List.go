@@ -0,0 +1,67 @@
+package migration
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListOpts struct {
+	// Offset of the first task to query. By default, this parameter is set to 0.
+	Offset int `q:"offset"`
+	// Maximum number of tasks to return. By default, 10 tasks are returned.
+	Limit int `q:"limit"`
+	// Name of the migration task. Fuzzy match is supported.
+	Name string `q:"name"`
+}
+
+// List returns the data migration tasks.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (*ListResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("migration-tasks").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET /v2/{project_id}/migration-tasks
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	// Number of migration tasks.
+	Count int `json:"count"`
+	// Migration tasks.
+	MigrationTasks []MigrationTask `json:"migration_tasks"`
+}
+
+type MigrationTask struct {
+	// ID of the migration task.
+	TaskId string `json:"task_id"`
+	// Name of the migration task.
+	TaskName string `json:"task_name"`
+	// Migration task status, see CreateResponse.Status.
+	Status string `json:"status"`
+	// Mode of the migration: backupfile_import or online_migration.
+	MigrationType string `json:"migration_type"`
+	// Type of the migration: full_amount_migration or incremental_migration.
+	MigrationMethod string `json:"migration_method"`
+	// Data source, the OBS bucket name or the source Redis address.
+	DataSource string `json:"data_source"`
+	// Source DCS instance name.
+	SourceInstanceName string `json:"source_instance_name"`
+	// Source DCS instance ID.
+	SourceInstanceId string `json:"source_instance_id"`
+	// Destination Redis address.
+	TargetInstanceAddrs string `json:"target_instance_addrs"`
+	// Destination DCS instance name.
+	TargetInstanceName string `json:"target_instance_name"`
+	// Destination DCS instance ID.
+	TargetInstanceId string `json:"target_instance_id"`
+	// Time when the migration task is created.
+	CreatedAt string `json:"created_at"`
+}
@@ -0,0 +1,21 @@
+package migration
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// Stop stops the data migration task.
+func Stop(client *golangsdk.ServiceClient, taskId string) (*CreateResponse, error) {
+	// POST /v2/{project_id}/migration-task/{task_id}/stop
+	raw, err := client.Post(client.ServiceURL("migration-task", taskId, "stop"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res CreateResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,32 @@
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForMigration waits up to timeout seconds until the migration task succeeds. An incremental
+// migration never finishes on its own: it is done waiting for once the incremental phase starts.
+func WaitForMigration(client *golangsdk.ServiceClient, taskId string, timeout int) (*MigrationTaskDetails, error) {
+	var task *MigrationTaskDetails
+	err := golangsdk.WaitFor(timeout, func() (bool, error) {
+		cur, err := Get(client, taskId)
+		if err != nil {
+			return false, err
+		}
+		task = cur
+
+		switch cur.Status {
+		case "SUCCESS", "MIGRATION_SUCCESS", "INCRMIGEATING":
+			return true, nil
+		case "FAILED", "MIGRATION_FAILED", "ERROR", "TERMINATED", "DELETED", "RELEASED":
+			return false, fmt.Errorf("migration task %s is %s", taskId, cur.Status)
+		}
+
+		time.Sleep(5 * time.Second)
+		return false, nil
+	})
+	return task, err
+}
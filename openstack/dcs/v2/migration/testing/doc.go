@@ -0,0 +1,2 @@
+// migration unit tests
+package testing
@@ -0,0 +1,74 @@
+package testing
+
+const createRequest = `
+{
+    "task_name": "migrate-redis",
+    "migration_type": "online_migration",
+    "migration_method": "incremental_migration",
+    "network_type": "vpc",
+    "source_instance": {
+        "addrs": "192.168.0.10:6379",
+        "password": "Secret-123"
+    },
+    "target_instance": {
+        "id": "target-id",
+        "password": "Secret-456"
+    }
+}
+`
+
+const createResponse = `
+{
+    "id": "task-id",
+    "name": "migrate-redis",
+    "status": "MIGRATING"
+}
+`
+
+const getResponse = `
+{
+    "task_id": "task-id",
+    "task_name": "migrate-redis",
+    "description": "",
+    "status": "%s",
+    "migration_type": "online_migration",
+    "migration_method": "incremental_migration",
+    "network_type": "vpc",
+    "source_instance": {
+        "addrs": "192.168.0.10:6379"
+    },
+    "target_instance": {
+        "id": "target-id",
+        "name": "dcs-target",
+        "addrs": "192.168.0.20:6379"
+    },
+    "created_at": "2023-06-01T08:00:00.000Z",
+    "updated_at": "2023-06-01T08:05:00.000Z"
+}
+`
+
+const listResponse = `
+{
+    "count": 1,
+    "migration_tasks": [
+        {
+            "task_id": "task-id",
+            "task_name": "migrate-redis",
+            "status": "INCRMIGEATING",
+            "migration_type": "online_migration",
+            "migration_method": "incremental_migration",
+            "data_source": "192.168.0.10:6379",
+            "target_instance_addrs": "192.168.0.20:6379",
+            "target_instance_name": "dcs-target",
+            "target_instance_id": "target-id",
+            "created_at": "2023-06-01T08:00:00.000Z"
+        }
+    ]
+}
+`
+
+const deleteRequest = `
+{
+    "task_id_list": ["task-id"]
+}
+`
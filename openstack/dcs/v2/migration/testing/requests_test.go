@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/migration"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestMigration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/migration-task", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, createRequest)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+	var requests int
+	th.Mux.HandleFunc("/migration-task/task-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		requests++
+		status := "FULLMIGRATING"
+		if requests > 1 {
+			status = "INCRMIGEATING"
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, getResponse, status)
+	})
+	th.Mux.HandleFunc("/migration-task/task-id/stop", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"id": "task-id", "name": "migrate-redis", "status": "TERMINATING"}`)
+	})
+	th.Mux.HandleFunc("/migration-tasks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if r.Method == "DELETE" {
+			th.TestJSONRequest(t, r, deleteRequest)
+			_, _ = fmt.Fprint(w, deleteRequest)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"name": "migrate"})
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+
+	task, err := migration.Create(fake.ServiceClient(), migration.CreateOpts{
+		TaskName:        "migrate-redis",
+		MigrationType:   "online_migration",
+		MigrationMethod: "incremental_migration",
+		NetworkType:     "vpc",
+		SourceInstance:  &migration.SourceInstanceBody{Addrs: "192.168.0.10:6379", Password: "Secret-123"},
+		TargetInstance:  migration.TargetInstanceBody{Id: "target-id", Password: "Secret-456"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "task-id", task.Id)
+
+	details, err := migration.WaitForMigration(fake.ServiceClient(), task.Id, 30)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, requests)
+	th.AssertEquals(t, "dcs-target", details.TargetInstance.Name)
+
+	list, err := migration.List(fake.ServiceClient(), migration.ListOpts{Name: "migrate"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "192.168.0.10:6379", list.MigrationTasks[0].DataSource)
+
+	stopped, err := migration.Stop(fake.ServiceClient(), task.Id)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "TERMINATING", stopped.Status)
+
+	th.AssertNoErr(t, migration.Delete(fake.ServiceClient(), migration.DeleteOpts{TaskIdList: []string{task.Id}}))
+}
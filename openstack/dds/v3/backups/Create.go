@@ -0,0 +1,46 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type CreateOpts struct {
+	// Specifies the ID of the DB instance from which the backup is created.
+	InstanceId string `json:"instance_id" required:"true"`
+	// Specifies the manual backup name.
+	//
+	// The value must be 4 to 64 characters in length and start with a letter (from A to Z or from a to z). It is case-sensitive and can contain only letters, digits (from 0 to 9), hyphens (-), and underscores (_).
+	Name string `json:"name" required:"true"`
+	// Specifies the manual backup description.
+	//
+	// The description must consist of a maximum of 256 characters and cannot contain the following special characters: >!<"&'=
+	Description string `json:"description,omitempty"`
+}
+
+func Create(client *golangsdk.ServiceClient, opts CreateOpts) (*CreateResponse, error) {
+	b, err := build.RequestBody(opts, "backup")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/backups
+	raw, err := client.Post(client.ServiceURL("backups"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res CreateResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type CreateResponse struct {
+	// Indicates the ID of the asynchronous backup task.
+	JobId string `json:"job_id"`
+	// Indicates the backup ID.
+	BackupId string `json:"backup_id"`
+}
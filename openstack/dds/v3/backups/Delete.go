@@ -0,0 +1,12 @@
+package backups
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+// Delete deletes a manual backup and returns the ID of the deletion task.
+func Delete(client *golangsdk.ServiceClient, backupId string) (*string, error) {
+	// DELETE https://{Endpoint}/v3/{project_id}/backups/{backup_id}
+	raw, err := client.Delete(client.ServiceURL("backups", backupId), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
@@ -0,0 +1,19 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetPolicy returns the automated backup policy of the instance.
+func GetPolicy(client *golangsdk.ServiceClient, instanceId string) (*BackupPolicy, error) {
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/backups/policy
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "backups", "policy"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res BackupPolicy
+	err = extract.IntoStructPtr(raw.Body, &res, "backup_policy")
+	return &res, err
+}
@@ -0,0 +1,38 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListRestoreTimesOpts struct {
+	// Specifies the instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the date to be queried. The value is in the "yyyy-mm-dd" format, and the time zone is UTC.
+	Date string `q:"date"`
+}
+
+// ListRestoreTimes returns the time ranges of the day the instance can be restored to.
+func ListRestoreTimes(client *golangsdk.ServiceClient, opts ListRestoreTimesOpts) ([]RestoreTime, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", opts.InstanceId, "restore-time").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/restore-time
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []RestoreTime
+	err = extract.IntoSlicePtr(raw.Body, &res, "restore_times")
+	return res, err
+}
+
+type RestoreTime struct {
+	// Indicates the start time of the restoration time range in the UNIX timestamp format. The unit is millisecond and the time zone is UTC.
+	StartTime int64 `json:"start_time"`
+	// Indicates the end time of the restoration time range in the UNIX timestamp format. The unit is millisecond and the time zone is UTC.
+	EndTime int64 `json:"end_time"`
+}
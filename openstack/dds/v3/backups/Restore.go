@@ -0,0 +1,51 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type RestoreOpts struct {
+	// Specifies the restoration information.
+	Source Source `json:"source" required:"true"`
+	// Specifies the restoration target.
+	Target Target `json:"target" required:"true"`
+}
+
+type Source struct {
+	// Specifies the ID of the instance the backup or the point in time belongs to.
+	InstanceId string `json:"instance_id" required:"true"`
+	// Specifies the restoration mode.
+	//
+	// Valid value:
+	//
+	// backup: indicates restoration using the backup file specified by backup_id. This is the default value.
+	// timestamp: indicates the point-in-time restoration to the time specified by restore_time.
+	Type string `json:"type,omitempty"`
+	// Specifies the ID of the backup to be restored. This parameter is mandatory for the backup restoration mode.
+	BackupId string `json:"backup_id,omitempty"`
+	// Specifies the time point of data restoration in the UNIX timestamp. The unit is millisecond and the time zone is UTC.
+	//
+	// This parameter is mandatory for the point-in-time restoration mode, see ListRestoreTimes.
+	RestoreTime int64 `json:"restore_time,omitempty"`
+}
+
+type Target struct {
+	// Specifies the ID of the instance to which the data is restored. It can be the original instance or another instance.
+	InstanceId string `json:"instance_id" required:"true"`
+}
+
+// Restore restores a backup or a point in time to an existing DB instance, overwriting its data.
+// It returns the ID of the restoration task.
+func Restore(client *golangsdk.ServiceClient, opts RestoreOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/recovery
+	raw, err := client.Post(client.ServiceURL("instances", "recovery"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
@@ -0,0 +1,75 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+)
+
+type RestoreToNewOpts struct {
+	// Specifies the DB instance name.
+	//
+	// The instance name must contain 4 to 64 characters and must start with a letter. It is case sensitive and can contain letters, digits, hyphens (-), and underscores (_).
+	Name string `json:"name" required:"true"`
+	// Specifies the region ID.
+	Region string `json:"region,omitempty"`
+	// Specifies the AZ ID.
+	AvailabilityZone string `json:"availability_zone" required:"true"`
+	// Specifies the VPC ID.
+	VpcId string `json:"vpc_id" required:"true"`
+	// Specifies the network ID of the subnet.
+	SubnetId string `json:"subnet_id" required:"true"`
+	// Specifies the security group ID.
+	SecurityGroupId string `json:"security_group_id" required:"true"`
+	// Specifies the database password.
+	//
+	// If it is not transferred, the password of the original instance is used.
+	Password string `json:"password,omitempty"`
+	// Specifies the key ID used for disk encryption.
+	DiskEncryptionId string `json:"disk_encryption_id,omitempty"`
+	// Specifies the instance specifications. The node types and numbers must be the same as those of the original instance.
+	Flavor []instances.Flavor `json:"flavor" required:"true"`
+	// Specifies the advanced backup policy of the new instance.
+	BackupStrategy *instances.BackupStrategy `json:"backup_strategy,omitempty"`
+	// Specifies the restoration information.
+	RestorePoint RestorePoint `json:"restore_point" required:"true"`
+}
+
+type RestorePoint struct {
+	// Specifies the ID of the instance the backup or the point in time belongs to.
+	InstanceId string `json:"instance_id" required:"true"`
+	// Specifies the restoration mode.
+	//
+	// Valid value:
+	//
+	// backup: indicates restoration using the backup file specified by backup_id. This is the default value.
+	// timestamp: indicates the point-in-time restoration to the time specified by restore_time.
+	Type string `json:"type,omitempty"`
+	// Specifies the ID of the backup to be restored. This parameter is mandatory for the backup restoration mode.
+	BackupId string `json:"backup_id,omitempty"`
+	// Specifies the time point of data restoration in the UNIX timestamp. The unit is millisecond and the time zone is UTC.
+	//
+	// This parameter is mandatory for the point-in-time restoration mode, see ListRestoreTimes.
+	RestoreTime int64 `json:"restore_time,omitempty"`
+}
+
+// RestoreToNew creates a new DB instance from a backup or a point in time of an existing instance.
+func RestoreToNew(client *golangsdk.ServiceClient, opts RestoreToNewOpts) (*instances.Instance, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances
+	raw, err := client.Post(client.ServiceURL("instances"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res instances.Instance
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
@@ -0,0 +1,49 @@
+package backups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type SetPolicyOpts struct {
+	// Specifies the instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the backup policy.
+	BackupPolicy BackupPolicy `json:"backup_policy" required:"true"`
+}
+
+type BackupPolicy struct {
+	// Specifies the number of days to retain the generated backup files.
+	//
+	// The value range is from 0 to 732.
+	//
+	// If this parameter is set to 0, the automated backup policy is disabled.
+	KeepDays int `json:"keep_days"`
+	// Specifies the backup time window. Automated backups will be triggered during the backup time window.
+	//
+	// The value must be a valid value in the "hh:mm-HH:MM" format. The current time is in the UTC format.
+	//
+	// The HH value must be 1 greater than the hh value.
+	// The values of mm and MM must be the same and must be set to 00.
+	// This parameter is mandatory if the automated backup policy is enabled.
+	StartTime string `json:"start_time,omitempty"`
+	// Specifies the backup cycle configuration. Data will be automatically backed up on the selected days every week.
+	//
+	// The value is a list of digits separated by commas (,). Each digit indicates a day of the week: 1 is Monday and 7 is Sunday, e.g. "1,2,3,4,5,6,7".
+	// This parameter is mandatory if the automated backup policy is enabled.
+	Period string `json:"period,omitempty"`
+}
+
+// SetPolicy sets the automated backup policy of the instance.
+func SetPolicy(client *golangsdk.ServiceClient, opts SetPolicyOpts) error {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/instances/{instance_id}/backups/policy
+	_, err = client.Put(client.ServiceURL("instances", opts.InstanceId, "backups", "policy"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
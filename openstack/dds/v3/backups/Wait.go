@@ -0,0 +1,32 @@
+package backups
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForBackup waits up to secs seconds until the backup is completed, returning an error if it fails.
+// Use job.WaitForJobCompleted to wait for the tasks returned by Delete and Restore.
+func WaitForBackup(client *golangsdk.ServiceClient, secs int, backupId string) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		res, err := List(client, ListBackupsOpts{BackupId: backupId})
+		if err != nil {
+			return false, err
+		}
+		if len(res.Backups) == 0 {
+			return false, fmt.Errorf("backup %s not found", backupId)
+		}
+
+		switch res.Backups[0].Status {
+		case "COMPLETED":
+			return true, nil
+		case "FAILED":
+			return false, fmt.Errorf("backup %s failed", backupId)
+		}
+
+		time.Sleep(5 * time.Second)
+		return false, nil
+	})
+}
@@ -0,0 +1,149 @@
+package testing
+
+const createRequest = `
+{
+    "backup": {
+        "instance_id": "instance-id",
+        "name": "backup-1",
+        "description": "manual backup"
+    }
+}
+`
+
+const createResponse = `
+{
+    "job_id": "job-id",
+    "backup_id": "backup-id"
+}
+`
+
+const listResponse = `
+{
+    "backups": [
+        {
+            "id": "backup-id",
+            "name": "backup-1",
+            "instance_id": "instance-id",
+            "instance_name": "dds-1",
+            "datastore": {
+                "type": "DDS-Community",
+                "version": "4.0",
+                "storage_engine": "wiredTiger"
+            },
+            "type": "Manual",
+            "begin_time": "2023-06-01 08:00:00",
+            "end_time": "2023-06-01 08:05:00",
+            "status": "%s",
+            "size": 2803,
+            "description": "manual backup"
+        }
+    ],
+    "total_count": 1
+}
+`
+
+const policyRequest = `
+{
+    "backup_policy": {
+        "keep_days": 7,
+        "start_time": "23:00-00:00",
+        "period": "1,3,5"
+    }
+}
+`
+
+const policyResponse = `
+{
+    "backup_policy": {
+        "keep_days": 7,
+        "start_time": "23:00-00:00",
+        "period": "1,3,5"
+    }
+}
+`
+
+const restoreToNewRequest = `
+{
+    "name": "dds-restored",
+    "availability_zone": "eu-de-01",
+    "vpc_id": "vpc-id",
+    "subnet_id": "subnet-id",
+    "security_group_id": "sg-id",
+    "flavor": [
+        {
+            "type": "replica",
+            "num": 3,
+            "storage": "ULTRAHIGH",
+            "size": 10,
+            "spec_code": "dds.mongodb.s2.medium.4.repset"
+        }
+    ],
+    "restore_point": {
+        "instance_id": "instance-id",
+        "type": "timestamp",
+        "restore_time": 1685606400000
+    }
+}
+`
+
+const restoreToNewResponse = `
+{
+    "id": "new-instance-id",
+    "name": "dds-restored",
+    "status": "creating",
+    "availability_zone": "eu-de-01",
+    "vpc_id": "vpc-id",
+    "subnet_id": "subnet-id",
+    "security_group_id": "sg-id",
+    "mode": "ReplicaSet",
+    "job_id": "job-id"
+}
+`
+
+const restoreRequest = `
+{
+    "source": {
+        "instance_id": "instance-id",
+        "type": "backup",
+        "backup_id": "backup-id"
+    },
+    "target": {
+        "instance_id": "target-id"
+    }
+}
+`
+
+const jobResponse = `
+{
+    "job_id": "job-id"
+}
+`
+
+const restoreTimesResponse = `
+{
+    "restore_times": [
+        {
+            "start_time": 1685577600000,
+            "end_time": 1685606400000
+        }
+    ]
+}
+`
+
+const jobStatusResponse = `
+{
+    "job": {
+        "id": "job-id",
+        "name": "Restore_Replica_Set",
+        "status": "Completed",
+        "created": "2023-06-01T08:00:00+0000",
+        "ended": "2023-06-01T08:10:00+0000",
+        "progress": "",
+        "fail_reason": "",
+        "instance": {
+            "id": "target-id",
+            "name": "dds-2"
+        }
+    }
+}
+`
@@ -0,0 +1,192 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/job"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, createRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+
+	res, err := backups.Create(fake.ServiceClient(), backups.CreateOpts{
+		InstanceId:  "instance-id",
+		Name:        "backup-1",
+		Description: "manual backup",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "job-id", res.JobId)
+	th.AssertEquals(t, "backup-id", res.BackupId)
+}
+
+func TestListAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var requests int
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"backup_id": "backup-id"})
+		requests++
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, listResponse, "COMPLETED")
+	})
+
+	res, err := backups.List(fake.ServiceClient(), backups.ListBackupsOpts{BackupId: "backup-id"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, res.TotalCount)
+	th.AssertEquals(t, "Manual", res.Backups[0].Type)
+	th.AssertEquals(t, int64(2803), res.Backups[0].Size)
+	th.AssertEquals(t, "wiredTiger", res.Backups[0].Datastore.StorageEngine)
+
+	th.AssertNoErr(t, backups.WaitForBackup(fake.ServiceClient(), 10, "backup-id"))
+	th.AssertEquals(t, 2, requests)
+}
+
+func TestWaitForFailedBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, listResponse, "FAILED")
+	})
+
+	err := backups.WaitForBackup(fake.ServiceClient(), 10, "backup-id")
+	th.AssertEquals(t, "backup backup-id failed", err.Error())
+}
+
+func TestPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/backups/policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if r.Method == "PUT" {
+			th.TestJSONRequest(t, r, policyRequest)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, policyResponse)
+	})
+
+	policy := backups.BackupPolicy{KeepDays: 7, StartTime: "23:00-00:00", Period: "1,3,5"}
+	err := backups.SetPolicy(fake.ServiceClient(), backups.SetPolicyOpts{InstanceId: "instance-id", BackupPolicy: policy})
+	th.AssertNoErr(t, err)
+
+	res, err := backups.GetPolicy(fake.ServiceClient(), "instance-id")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, policy, *res)
+}
+
+func TestRestoreToNew(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, restoreToNewRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, restoreToNewResponse)
+	})
+
+	res, err := backups.RestoreToNew(fake.ServiceClient(), backups.RestoreToNewOpts{
+		Name:             "dds-restored",
+		AvailabilityZone: "eu-de-01",
+		VpcId:            "vpc-id",
+		SubnetId:         "subnet-id",
+		SecurityGroupId:  "sg-id",
+		Flavor: []instances.Flavor{{
+			Type:     "replica",
+			Num:      3,
+			Storage:  "ULTRAHIGH",
+			Size:     10,
+			SpecCode: "dds.mongodb.s2.medium.4.repset",
+		}},
+		RestorePoint: backups.RestorePoint{
+			InstanceId:  "instance-id",
+			Type:        "timestamp",
+			RestoreTime: 1685606400000,
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "new-instance-id", res.Id)
+	th.AssertEquals(t, "job-id", res.JobId)
+}
+
+func TestRestore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/recovery", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, restoreRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"id": "job-id"})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, jobStatusResponse)
+	})
+
+	jobId, err := backups.Restore(fake.ServiceClient(), backups.RestoreOpts{
+		Source: backups.Source{InstanceId: "instance-id", Type: "backup", BackupId: "backup-id"},
+		Target: backups.Target{InstanceId: "target-id"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "job-id", *jobId)
+	th.AssertNoErr(t, job.WaitForJobCompleted(fake.ServiceClient(), 10, *jobId))
+}
+
+func TestListRestoreTimes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/restore-time", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"date": "2023-06-01"})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, restoreTimesResponse)
+	})
+
+	res, err := backups.ListRestoreTimes(fake.ServiceClient(), backups.ListRestoreTimesOpts{InstanceId: "instance-id", Date: "2023-06-01"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []backups.RestoreTime{{StartTime: 1685577600000, EndTime: 1685606400000}}, res)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/backup-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+
+	jobId, err := backups.Delete(fake.ServiceClient(), "backup-id")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "job-id", *jobId)
+}
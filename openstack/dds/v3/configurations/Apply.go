@@ -0,0 +1,42 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ApplyOpts struct {
+	// Specifies the parameter template ID.
+	ConfigId string `json:"-" required:"true"`
+	// Specifies the entity IDs the template is applied to: the IDs of the nodes or groups of a cluster
+	// instance of the same node type as the template, or the IDs of replica set and single node instances.
+	EntityIds []string `json:"entity_ids" required:"true"`
+}
+
+// Apply applies the parameter template to the entities. Use job.WaitForJobCompleted to wait for the returned task.
+func Apply(client *golangsdk.ServiceClient, opts ApplyOpts) (*ApplyResponse, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/configurations/{config_id}/apply
+	raw, err := client.Put(client.ServiceURL("configurations", opts.ConfigId, "apply"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ApplyResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ApplyResponse struct {
+	// Indicates the ID of the task applying the template.
+	JobId string `json:"job_id"`
+	// Indicates whether the template is applied successfully.
+	Success bool `json:"success"`
+}
@@ -0,0 +1,58 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type CreateOpts struct {
+	// Specifies the parameter template name.
+	//
+	// The value must be 1 to 64 characters long and can contain only letters, digits, hyphens (-), underscores (_), and periods (.).
+	Name string `json:"name" required:"true"`
+	// Specifies the parameter template description.
+	//
+	// The value must be up to 256 characters long and cannot contain the following special characters: >!<"&'=
+	Description string `json:"description,omitempty"`
+	// Specifies the parameter values defined by users based on the default parameter template.
+	// The default parameter values are used if not set.
+	ParameterValues map[string]string `json:"parameter_values,omitempty"`
+	// Specifies the database information.
+	Datastore Datastore `json:"datastore" required:"true"`
+}
+
+type Datastore struct {
+	// Specifies the database type. The value is DDS-Community.
+	Type string `json:"type" required:"true"`
+	// Specifies the database version.
+	Version string `json:"version" required:"true"`
+	// Specifies the node type of the parameter template.
+	//
+	// Valid value:
+	//
+	// For a cluster instance, the value can be mongos, shard, or config.
+	// For a replica set instance, the value is replica.
+	// For a single node instance, the value is single.
+	NodeType string `json:"node_type" required:"true"`
+}
+
+// Create creates a parameter template.
+func Create(client *golangsdk.ServiceClient, opts CreateOpts) (*Configuration, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/configurations
+	raw, err := client.Post(client.ServiceURL("configurations"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res Configuration
+	err = extract.IntoStructPtr(raw.Body, &res, "configuration")
+	return &res, err
+}
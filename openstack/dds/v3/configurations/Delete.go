@@ -0,0 +1,12 @@
+package configurations
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+// Delete deletes a custom parameter template.
+func Delete(client *golangsdk.ServiceClient, configId string) error {
+	// DELETE https://{Endpoint}/v3/{project_id}/configurations/{config_id}
+	_, err := client.Delete(client.ServiceURL("configurations", configId), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
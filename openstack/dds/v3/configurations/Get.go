@@ -0,0 +1,55 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// Get returns the parameter template with its parameters.
+func Get(client *golangsdk.ServiceClient, configId string) (*ConfigurationDetails, error) {
+	// GET https://{Endpoint}/v3/{project_id}/configurations/{config_id}
+	raw, err := client.Get(client.ServiceURL("configurations", configId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ConfigurationDetails
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ConfigurationDetails struct {
+	// Indicates the parameter template ID.
+	Id string `json:"id"`
+	// Indicates the parameter template name.
+	Name string `json:"name"`
+	// Indicates the parameter template description.
+	Description string `json:"description"`
+	// Indicates the database version.
+	DatastoreVersion string `json:"datastore_version"`
+	// Indicates the database type.
+	DatastoreName string `json:"datastore_name"`
+	// Indicates the creation time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Created string `json:"created"`
+	// Indicates the update time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Updated string `json:"updated"`
+	// Indicates the parameters.
+	Parameters []Parameter `json:"parameters"`
+}
+
+type Parameter struct {
+	// Indicates the parameter name.
+	Name string `json:"name"`
+	// Indicates the parameter value.
+	Value string `json:"value"`
+	// Indicates the parameter description.
+	Description string `json:"description"`
+	// Indicates the parameter type. The value can be integer, string, boolean, float, or list.
+	Type string `json:"type"`
+	// Indicates the value range. For example, the value of integer is 0 or 1, and the value of boolean is true or false.
+	ValueRange string `json:"value_range"`
+	// Indicates whether the instance needs to be restarted for the parameter change to take effect.
+	RestartRequired bool `json:"restart_required"`
+	// Indicates whether the parameter is read-only.
+	ReadOnly bool `json:"readonly"`
+}
@@ -0,0 +1,31 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type GetInstanceConfigurationOpts struct {
+	// Specifies the instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the entity ID: the ID of a node or group of a cluster instance, or the ID of a replica set or single node instance.
+	EntityId string `q:"entity_id"`
+}
+
+// GetInstanceConfiguration returns the parameters of the instance entity.
+func GetInstanceConfiguration(client *golangsdk.ServiceClient, opts GetInstanceConfigurationOpts) (*ConfigurationDetails, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", opts.InstanceId, "configurations").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/configurations
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ConfigurationDetails
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
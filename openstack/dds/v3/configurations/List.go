@@ -0,0 +1,76 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListOpts struct {
+	// Specifies the index position. If offset is set to N, the resource query starts from the N+1 piece of data.
+	//
+	// The value must be greater than or equal to 0. If this parameter is not transferred, offset is set to 0 by default.
+	Offset int `q:"offset"`
+	// Specifies the maximum allowed number of parameter templates.
+	//
+	// The value ranges from 1 to 100. If this parameter is not transferred, the first 100 parameter templates are queried by default.
+	Limit int `q:"limit"`
+}
+
+// List returns the parameter templates, including the default templates of all databases and those created by users.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (*ListResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("configurations").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/configurations
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	// Indicates the total number of parameter templates.
+	Count int `json:"count"`
+	// Indicates the maximum number of parameter templates which can be created.
+	Quota int `json:"quota"`
+	// Indicates the parameter templates.
+	Configurations []Configuration `json:"configurations"`
+}
+
+type Configuration struct {
+	// Indicates the parameter template ID.
+	Id string `json:"id"`
+	// Indicates the parameter template name.
+	Name string `json:"name"`
+	// Indicates the parameter template description.
+	Description string `json:"description"`
+	// Indicates the database version.
+	DatastoreVersion string `json:"datastore_version"`
+	// Indicates the database type.
+	DatastoreName string `json:"datastore_name"`
+	// Indicates the node type of the parameter template.
+	//
+	// Valid value:
+	//
+	// mongos: the mongos node type.
+	// shard: the shard node type.
+	// config: the config node type.
+	// replica: the replica set instance type.
+	// single: the single node instance type.
+	NodeType string `json:"node_type"`
+	// Indicates the creation time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Created string `json:"created"`
+	// Indicates the update time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Updated string `json:"updated"`
+	// Indicates whether the parameter template is created by users.
+	//
+	// false: The parameter template is a default parameter template.
+	// true: The parameter template is a custom template.
+	UserDefined bool `json:"user_defined"`
+}
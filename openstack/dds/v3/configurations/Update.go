@@ -0,0 +1,32 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type UpdateOpts struct {
+	// Specifies the parameter template ID.
+	ConfigId string `json:"-" required:"true"`
+	// Specifies the new parameter template name.
+	Name string `json:"name,omitempty"`
+	// Specifies the new parameter template description.
+	Description string `json:"description,omitempty"`
+	// Specifies the changed parameter values.
+	ParameterValues map[string]string `json:"parameter_values,omitempty"`
+}
+
+// Update changes the name, description or parameter values of a custom parameter template.
+// The changes are not applied to the instances using the template, see Apply.
+func Update(client *golangsdk.ServiceClient, opts UpdateOpts) error {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/configurations/{config_id}
+	_, err = client.Put(client.ServiceURL("configurations", opts.ConfigId), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
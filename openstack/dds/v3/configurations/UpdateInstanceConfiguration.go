@@ -0,0 +1,43 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type UpdateInstanceConfigurationOpts struct {
+	// Specifies the instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the entity ID: the ID of a node or group of a cluster instance, or the ID of a replica set or single node instance.
+	EntityId string `json:"entity_id" required:"true"`
+	// Specifies the changed parameter values.
+	ParameterValues map[string]string `json:"parameter_values" required:"true"`
+}
+
+// UpdateInstanceConfiguration changes the parameters of the instance entity. Use job.WaitForJobCompleted to wait for the returned task.
+func UpdateInstanceConfiguration(client *golangsdk.ServiceClient, opts UpdateInstanceConfigurationOpts) (*UpdateInstanceConfigurationResponse, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/instances/{instance_id}/configurations
+	raw, err := client.Put(client.ServiceURL("instances", opts.InstanceId, "configurations"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res UpdateInstanceConfigurationResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type UpdateInstanceConfigurationResponse struct {
+	// Indicates the ID of the task changing the parameters.
+	JobId string `json:"job_id"`
+	// Indicates whether the instance needs to be restarted for the changes to take effect.
+	RestartRequired bool `json:"restart_required"`
+}
@@ -0,0 +1,2 @@
+// configurations unit tests
+package testing
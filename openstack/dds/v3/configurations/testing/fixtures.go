@@ -0,0 +1,112 @@
+package testing
+
+const createRequest = `
+{
+    "name": "mongo-shard",
+    "description": "shard parameters",
+    "parameter_values": {
+        "connPoolMaxConnsPerHost": "800"
+    },
+    "datastore": {
+        "type": "DDS-Community",
+        "version": "4.0",
+        "node_type": "shard"
+    }
+}
+`
+
+const createResponse = `
+{
+    "configuration": {
+        "id": "config-id",
+        "name": "mongo-shard",
+        "description": "shard parameters",
+        "datastore_version": "4.0",
+        "datastore_name": "DDS-Community",
+        "node_type": "shard",
+        "created": "2023-06-01T08:00:00+0000",
+        "updated": "2023-06-01T08:00:00+0000"
+    }
+}
+`
+
+const listResponse = `
+{
+    "count": 1,
+    "quota": 100,
+    "configurations": [
+        {
+            "id": "config-id",
+            "name": "mongo-shard",
+            "description": "shard parameters",
+            "datastore_version": "4.0",
+            "datastore_name": "DDS-Community",
+            "node_type": "shard",
+            "created": "2023-06-01T08:00:00+0000",
+            "updated": "2023-06-01T08:00:00+0000",
+            "user_defined": true
+        }
+    ]
+}
+`
+
+const getResponse = `
+{
+    "id": "config-id",
+    "name": "mongo-shard",
+    "description": "shard parameters",
+    "datastore_version": "4.0",
+    "datastore_name": "DDS-Community",
+    "created": "2023-06-01T08:00:00+0000",
+    "updated": "2023-06-01T08:00:00+0000",
+    "parameters": [
+        {
+            "name": "connPoolMaxConnsPerHost",
+            "value": "800",
+            "description": "Maximum number of simultaneous outgoing connections in the connection pool",
+            "type": "integer",
+            "value_range": "200-1000",
+            "restart_required": true,
+            "readonly": false
+        }
+    ]
+}
+`
+
+const updateRequest = `
+{
+    "name": "mongo-shard-2",
+    "parameter_values": {
+        "connPoolMaxConnsPerHost": "1000"
+    }
+}
+`
+
+const applyRequest = `
+{
+    "entity_ids": ["group-1", "group-2"]
+}
+`
+
+const applyResponse = `
+{
+    "job_id": "job-id",
+    "success": true
+}
+`
+
+const updateInstanceRequest = `
+{
+    "entity_id": "instance-id",
+    "parameter_values": {
+        "net.maxIncomingConnections": "1000"
+    }
+}
+`
+
+const updateInstanceResponse = `
+{
+    "job_id": "job-id",
+    "restart_required": true
+}
+`
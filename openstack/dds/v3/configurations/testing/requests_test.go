@@ -0,0 +1,137 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/configurations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		if r.Method == "POST" {
+			th.TestJSONRequest(t, r, createRequest)
+			_, _ = fmt.Fprint(w, createResponse)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"limit": "10"})
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+
+	created, err := configurations.Create(fake.ServiceClient(), configurations.CreateOpts{
+		Name:            "mongo-shard",
+		Description:     "shard parameters",
+		ParameterValues: map[string]string{"connPoolMaxConnsPerHost": "800"},
+		Datastore:       configurations.Datastore{Type: "DDS-Community", Version: "4.0", NodeType: "shard"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "config-id", created.Id)
+	th.AssertEquals(t, "shard", created.NodeType)
+
+	list, err := configurations.List(fake.ServiceClient(), configurations.ListOpts{Limit: 10})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.Count)
+	th.AssertEquals(t, true, list.Configurations[0].UserDefined)
+}
+
+func TestGetUpdateDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/configurations/config-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			_, _ = fmt.Fprint(w, getResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, updateRequest)
+			w.WriteHeader(http.StatusOK)
+		default:
+			th.TestMethod(t, r, "DELETE")
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+
+	config, err := configurations.Get(fake.ServiceClient(), "config-id")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []configurations.Parameter{{
+		Name:            "connPoolMaxConnsPerHost",
+		Value:           "800",
+		Description:     "Maximum number of simultaneous outgoing connections in the connection pool",
+		Type:            "integer",
+		ValueRange:      "200-1000",
+		RestartRequired: true,
+	}}, config.Parameters)
+
+	err = configurations.Update(fake.ServiceClient(), configurations.UpdateOpts{
+		ConfigId:        "config-id",
+		Name:            "mongo-shard-2",
+		ParameterValues: map[string]string{"connPoolMaxConnsPerHost": "1000"},
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, configurations.Delete(fake.ServiceClient(), "config-id"))
+}
+
+func TestApply(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/configurations/config-id/apply", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, applyRequest)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, applyResponse)
+	})
+
+	res, err := configurations.Apply(fake.ServiceClient(), configurations.ApplyOpts{
+		ConfigId:  "config-id",
+		EntityIds: []string{"group-1", "group-2"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "job-id", res.JobId)
+	th.AssertEquals(t, true, res.Success)
+}
+
+func TestInstanceConfiguration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/configurations", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if r.Method == "PUT" {
+			th.TestJSONRequest(t, r, updateInstanceRequest)
+			_, _ = fmt.Fprint(w, updateInstanceResponse)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"entity_id": "instance-id"})
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+
+	config, err := configurations.GetInstanceConfiguration(fake.ServiceClient(), configurations.GetInstanceConfigurationOpts{
+		InstanceId: "instance-id",
+		EntityId:   "instance-id",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(config.Parameters))
+
+	res, err := configurations.UpdateInstanceConfiguration(fake.ServiceClient(), configurations.UpdateInstanceConfigurationOpts{
+		InstanceId:      "instance-id",
+		EntityId:        "instance-id",
+		ParameterValues: map[string]string{"net.maxIncomingConnections": "1000"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "job-id", res.JobId)
+	th.AssertEquals(t, true, res.RestartRequired)
+}
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForJobCompleted waits up to secs seconds until the task completes, returning an error if it fails.
+func WaitForJobCompleted(client *golangsdk.ServiceClient, secs int, jobID string) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		job, err := Get(client, jobID)
+		if err != nil {
+			return false, err
+		}
+
+		switch job.Status {
+		case "Completed":
+			return true, nil
+		case "Failed":
+			return false, fmt.Errorf("job %s failed: %s", jobID, job.FailReason)
+		}
+
+		time.Sleep(5 * time.Second)
+		return false, nil
+	})
+}
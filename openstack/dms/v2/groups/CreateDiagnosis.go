@@ -0,0 +1,37 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type DiagnosisOpts struct {
+	// Consumer group name.
+	GroupName string `json:"group_name" required:"true"`
+	// Topic consumed by the group.
+	TopicName string `json:"topic_name" required:"true"`
+}
+
+// CreateDiagnosis starts diagnosing the message accumulation of the consumer group on the topic
+// and returns the ID of the report, see WaitForDiagnosis.
+func CreateDiagnosis(client *golangsdk.ServiceClient, instanceId string, opts DiagnosisOpts) (string, error) {
+	// POST /v2/{project_id}/kafka/instances/{instance_id}/message-diagnosis-tasks
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := client.Post(client.ServiceURL("kafka", "instances", instanceId, "message-diagnosis-tasks"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var res struct {
+		ReportId string `json:"report_id"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return res.ReportId, err
+}
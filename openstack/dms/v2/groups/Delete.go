@@ -0,0 +1,36 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// Delete deletes the consumer groups and returns the ones which couldn't be deleted.
+func Delete(client *golangsdk.ServiceClient, instanceId string, groups []string) ([]FailedGroup, error) {
+	// POST /v2/{project_id}/instances/{instance_id}/groups/batch-delete
+	b, err := build.RequestBody(struct {
+		GroupIds []string `json:"group_ids" required:"true"`
+	}{GroupIds: groups}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Post(client.ServiceURL("instances", instanceId, "groups", "batch-delete"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []FailedGroup
+	err = extract.IntoSlicePtr(raw.Body, &res, "failed_groups")
+	return res, err
+}
+
+type FailedGroup struct {
+	// Consumer group name.
+	GroupId string `json:"group_id"`
+	// Reason the group wasn't deleted.
+	ErrorMessage string `json:"error_message"`
+}
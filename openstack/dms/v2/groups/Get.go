@@ -0,0 +1,80 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// Get returns the members and the per partition offsets and lag of the consumer group.
+func Get(client *golangsdk.ServiceClient, instanceId, group string) (*GroupDetails, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/groups/{group}
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "groups", group), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res GroupDetails
+	err = extract.IntoStructPtr(raw.Body, &res, "group")
+	return &res, err
+}
+
+type GroupDetails struct {
+	// Consumer group name.
+	GroupId string `json:"group_id"`
+	// Consumer group status, see Group.State.
+	State string `json:"state"`
+	// ID of the broker acting as the coordinator of the group.
+	CoordinatorId int `json:"coordinator_id"`
+	// Partition assignment strategy of the group.
+	AssignmentStrategy string `json:"assignment_strategy"`
+	// Consumer group description.
+	GroupDesc string `json:"group_desc"`
+	// Total number of messages accumulated in the group.
+	Lag int64 `json:"lag"`
+	// Creation time in milliseconds since the epoch.
+	CreatedAt int64 `json:"createdAt"`
+	// Consumers of the group.
+	Members []Member `json:"members"`
+	// Consumer offsets of the group.
+	GroupMessageOffsets []MessageOffset `json:"group_message_offsets"`
+}
+
+type Member struct {
+	// Consumer ID.
+	MemberId string `json:"member_id"`
+	// Client ID.
+	ClientId string `json:"client_id"`
+	// Consumer address.
+	Host string `json:"host"`
+	// Partitions assigned to the consumer.
+	Assignment []Assignment `json:"assignment"`
+}
+
+type Assignment struct {
+	// Topic name.
+	Topic string `json:"topic"`
+	// Partitions of the topic.
+	Partitions []int `json:"partitions"`
+}
+
+type MessageOffset struct {
+	// Topic name.
+	Topic string `json:"topic"`
+	// Partition number.
+	Partition int `json:"partition"`
+	// Number of messages accumulated in the partition.
+	Lag int64 `json:"lag"`
+	// Offset committed by the group.
+	MessageCurrentOffset int64 `json:"message_current_offset"`
+	// Log end offset of the partition.
+	MessageLogEndOffset int64 `json:"message_log_end_offset"`
+}
+
+// TopicLag returns the number of messages accumulated in the group per topic.
+func (d *GroupDetails) TopicLag() map[string]int64 {
+	lag := make(map[string]int64)
+	for _, offset := range d.GroupMessageOffsets {
+		lag[offset.Topic] += offset.Lag
+	}
+	return lag
+}
@@ -0,0 +1,56 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetDiagnosisReport returns the results of the diagnosis.
+func GetDiagnosisReport(client *golangsdk.ServiceClient, instanceId, reportId string) ([]DiagnosisDimension, error) {
+	// GET /v2/{project_id}/kafka/instances/{instance_id}/message-diagnosis-tasks/{report_id}
+	raw, err := client.Get(client.ServiceURL("kafka", "instances", instanceId, "message-diagnosis-tasks", reportId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []DiagnosisDimension
+	err = extract.IntoSlicePtr(raw.Body, &res, "diagnosis_dimension_list")
+	return res, err
+}
+
+type DiagnosisDimension struct {
+	// Diagnosis dimension name.
+	Name string `json:"name"`
+	// Number of abnormal diagnosis items.
+	AbnormalNum int `json:"abnormal_num"`
+	// Number of diagnosis items which couldn't be checked.
+	FailedNum int `json:"failed_num"`
+	// Diagnosis items of the dimension.
+	Items []DiagnosisItem `json:"diagnosis_item_list"`
+}
+
+type DiagnosisItem struct {
+	// Diagnosis item name.
+	Name string `json:"name"`
+	// Diagnosis result: normal, abnormal or failed.
+	Result string `json:"result"`
+	// Causes of the abnormal result.
+	CauseIds []DiagnosisCause `json:"cause_ids"`
+	// Suggestions for fixing the abnormal result.
+	AdviceIds []DiagnosisCause `json:"advice_ids"`
+	// Partitions affected.
+	Partitions []int `json:"partitions"`
+	// Partitions which couldn't be checked.
+	FailedPartitions []int `json:"failed_partitions"`
+	// Brokers affected.
+	BrokerIds []int `json:"broker_ids"`
+}
+
+type DiagnosisCause struct {
+	// ID of the cause or suggestion, describing the problem.
+	CauseId string `json:"cause_id"`
+	// Partitions affected.
+	Partitions []int `json:"partitions"`
+	// Brokers affected.
+	BrokerIds []int `json:"broker_ids"`
+}
@@ -0,0 +1,58 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListOpts struct {
+	// Offset of the first consumer group to query.
+	Offset int `q:"offset"`
+	// Maximum number of consumer groups to return.
+	Limit int `q:"limit"`
+	// Consumer group name to filter by. Fuzzy match is supported.
+	Group string `q:"group"`
+}
+
+// List returns the consumer groups of the instance with their total lag.
+func List(client *golangsdk.ServiceClient, instanceId string, opts ListOpts) (*ListResponse, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/groups
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, "groups").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	Groups []Group `json:"groups"`
+	Total  int     `json:"total"`
+}
+
+type Group struct {
+	// Consumer group name.
+	GroupId string `json:"group_id"`
+	// Consumer group status. Options:
+	// Dead: The consumer group has no members and no metadata.
+	// Empty: The consumer group has metadata but has no members.
+	// PreparingRebalance: The consumer group is to be rebalanced.
+	// CompletingRebalance: All members have joined the group.
+	// Stable: Members in the consumer group can consume messages normally.
+	State string `json:"state"`
+	// ID of the broker acting as the coordinator of the group.
+	CoordinatorId int `json:"coordinator_id"`
+	// Total number of messages accumulated in the group.
+	Lag int64 `json:"lag"`
+	// Consumer group description.
+	GroupDesc string `json:"group_desc"`
+	// Creation time in milliseconds since the epoch.
+	CreatedAt int64 `json:"createdAt"`
+}
@@ -0,0 +1,52 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListDiagnosisReportsOpts struct {
+	// Offset of the first report to query.
+	Offset int `q:"offset"`
+	// Maximum number of reports to return.
+	Limit int `q:"limit"`
+}
+
+// ListDiagnosisReports returns the diagnosis reports of the instance.
+func ListDiagnosisReports(client *golangsdk.ServiceClient, instanceId string, opts ListDiagnosisReportsOpts) (*ListDiagnosisReportsResponse, error) {
+	// GET /v2/{project_id}/kafka/instances/{instance_id}/message-diagnosis-tasks
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("kafka", "instances", instanceId, "message-diagnosis-tasks").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListDiagnosisReportsResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListDiagnosisReportsResponse struct {
+	Reports  []DiagnosisReport `json:"report_list"`
+	TotalNum int               `json:"total_num"`
+}
+
+type DiagnosisReport struct {
+	// Report ID.
+	ReportId string `json:"report_id"`
+	// Diagnosis status. Options:
+	// diagnosing: The diagnosis is in progress.
+	// finished: The diagnosis is complete.
+	// failed: The diagnosis failed.
+	Status string `json:"status"`
+	// Diagnosis start time in milliseconds since the epoch.
+	BeginTime int64 `json:"begin_time"`
+	// Diagnosis end time in milliseconds since the epoch.
+	EndTime int64 `json:"end_time"`
+	// Number of partitions with accumulated messages.
+	AccumulatedPartitions int `json:"accumulated_partitions"`
+}
@@ -0,0 +1,35 @@
+package groups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type ResetOffsetsOpts struct {
+	// Topic name.
+	Topic string `json:"topic" required:"true"`
+	// Partition number. The value -1 resets the offsets of all partitions of the topic.
+	Partition *int `json:"partition" required:"true"`
+	// Offset to reset to. If the offset is earlier than the earliest offset of the partition,
+	// the earliest offset is used; if it is later than the latest offset, the latest offset is used.
+	// Either MessageOffset or Timestamp must be set.
+	MessageOffset *int64 `json:"message_offset,omitempty"`
+	// Time to reset to, in milliseconds since the epoch. The offsets are reset to the first
+	// messages produced at or after the time.
+	Timestamp *int64 `json:"timestamp,omitempty"`
+}
+
+// ResetOffsets resets the consumer offsets of the group. The group must have no active consumers.
+func ResetOffsets(client *golangsdk.ServiceClient, instanceId, group string, opts ResetOffsetsOpts) error {
+	// POST /v2/{project_id}/instances/{instance_id}/management/groups/{group}/reset-message-offset
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	url := client.ServiceURL("instances", instanceId, "management", "groups", group, "reset-message-offset")
+	_, err = client.Post(url, b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return err
+}
@@ -0,0 +1,49 @@
+package groups
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForDiagnosis waits up to timeout seconds until the diagnosis finishes and returns its results.
+func WaitForDiagnosis(client *golangsdk.ServiceClient, instanceId, reportId string, timeout int) ([]DiagnosisDimension, error) {
+	err := golangsdk.WaitFor(timeout, func() (bool, error) {
+		report, err := findDiagnosisReport(client, instanceId, reportId)
+		if err != nil {
+			return false, err
+		}
+
+		switch report.Status {
+		case "finished":
+			return true, nil
+		case "failed":
+			return false, fmt.Errorf("diagnosis %s of instance %s failed", reportId, instanceId)
+		}
+
+		time.Sleep(2 * time.Second)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return GetDiagnosisReport(client, instanceId, reportId)
+}
+
+func findDiagnosisReport(client *golangsdk.ServiceClient, instanceId, reportId string) (*DiagnosisReport, error) {
+	for offset := 0; ; offset += 50 {
+		res, err := ListDiagnosisReports(client, instanceId, ListDiagnosisReportsOpts{Offset: offset, Limit: 50})
+		if err != nil {
+			return nil, err
+		}
+		for i := range res.Reports {
+			if res.Reports[i].ReportId == reportId {
+				return &res.Reports[i], nil
+			}
+		}
+		if len(res.Reports) == 0 || offset+len(res.Reports) >= res.TotalNum {
+			return nil, fmt.Errorf("diagnosis report %s of instance %s not found", reportId, instanceId)
+		}
+	}
+}
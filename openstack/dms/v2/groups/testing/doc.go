@@ -0,0 +1,2 @@
+// groups unit tests
+package testing
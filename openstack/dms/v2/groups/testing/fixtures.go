@@ -0,0 +1,147 @@
+package testing
+
+const listResponse = `
+{
+    "groups": [
+        {
+            "createdAt": 1685606400000,
+            "group_id": "billing",
+            "state": "Stable",
+            "coordinator_id": 1,
+            "lag": 120,
+            "group_desc": ""
+        }
+    ],
+    "total": 1
+}
+`
+
+const getResponse = `
+{
+    "group": {
+        "group_id": "billing",
+        "state": "Stable",
+        "coordinator_id": 1,
+        "assignment_strategy": "range",
+        "createdAt": 1685606400000,
+        "lag": 120,
+        "members": [
+            {
+                "host": "/192.168.0.10",
+                "member_id": "consumer-1-9f4c",
+                "client_id": "consumer-1",
+                "assignment": [
+                    {
+                        "topic": "invoices",
+                        "partitions": [0, 1]
+                    }
+                ]
+            }
+        ],
+        "group_message_offsets": [
+            {
+                "topic": "invoices",
+                "partition": 0,
+                "lag": 100,
+                "message_current_offset": 900,
+                "message_log_end_offset": 1000
+            },
+            {
+                "topic": "invoices",
+                "partition": 1,
+                "lag": 15,
+                "message_current_offset": 985,
+                "message_log_end_offset": 1000
+            },
+            {
+                "topic": "refunds",
+                "partition": 0,
+                "lag": 5,
+                "message_current_offset": 10,
+                "message_log_end_offset": 15
+            }
+        ]
+    }
+}
+`
+
+const resetRequest = `
+{
+    "topic": "invoices",
+    "partition": -1,
+    "timestamp": 1685606400000
+}
+`
+
+const deleteRequest = `
+{
+    "group_ids": ["billing", "unknown"]
+}
+`
+
+const deleteResponse = `
+{
+    "failed_groups": [
+        {
+            "group_id": "unknown",
+            "error_message": "group not found"
+        }
+    ],
+    "total": 1
+}
+`
+
+const diagnosisRequest = `
+{
+    "group_name": "billing",
+    "topic_name": "invoices"
+}
+`
+
+const listReportsResponse = `
+{
+    "report_list": [
+        {
+            "report_id": "report-id",
+            "status": "finished",
+            "begin_time": 1685606400000,
+            "end_time": 1685606460000,
+            "accumulated_partitions": 1
+        }
+    ],
+    "total_num": 1
+}
+`
+
+const reportResponse = `
+{
+    "diagnosis_dimension_list": [
+        {
+            "name": "consumer",
+            "abnormal_num": 1,
+            "failed_num": 0,
+            "diagnosis_item_list": [
+                {
+                    "name": "consumer_rebalance",
+                    "result": "abnormal",
+                    "cause_ids": [
+                        {
+                            "cause_id": "frequent_rebalance",
+                            "partitions": [0],
+                            "broker_ids": [1]
+                        }
+                    ],
+                    "advice_ids": [
+                        {
+                            "cause_id": "increase_session_timeout"
+                        }
+                    ],
+                    "partitions": [0],
+                    "failed_partitions": [],
+                    "broker_ids": [1]
+                }
+            ]
+        }
+    ]
+}
+`
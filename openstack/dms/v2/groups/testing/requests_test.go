@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/groups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestListAndGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"group": "bill"})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/groups/billing", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+
+	list, err := groups.List(fake.ServiceClient(), "instance-id", groups.ListOpts{Group: "bill"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.Total)
+	th.AssertEquals(t, int64(120), list.Groups[0].Lag)
+
+	group, err := groups.Get(fake.ServiceClient(), "instance-id", "billing")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []int{0, 1}, group.Members[0].Assignment[0].Partitions)
+	th.AssertDeepEquals(t, map[string]int64{"invoices": 115, "refunds": 5}, group.TopicLag())
+}
+
+func TestResetOffsets(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/management/groups/billing/reset-message-offset", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, resetRequest)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	timestamp := int64(1685606400000)
+	err := groups.ResetOffsets(fake.ServiceClient(), "instance-id", "billing", groups.ResetOffsetsOpts{
+		Topic:     "invoices",
+		Partition: pointerto.Int(-1),
+		Timestamp: &timestamp,
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/groups/batch-delete", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, deleteRequest)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, deleteResponse)
+	})
+
+	failed, err := groups.Delete(fake.ServiceClient(), "instance-id", []string{"billing", "unknown"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []groups.FailedGroup{{GroupId: "unknown", ErrorMessage: "group not found"}}, failed)
+}
+
+func TestDiagnosis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/kafka/instances/instance-id/message-diagnosis-tasks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		if r.Method == "POST" {
+			th.TestJSONRequest(t, r, diagnosisRequest)
+			_, _ = fmt.Fprint(w, `{"report_id": "report-id"}`)
+			return
+		}
+		th.TestMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, listReportsResponse)
+	})
+	th.Mux.HandleFunc("/kafka/instances/instance-id/message-diagnosis-tasks/report-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, reportResponse)
+	})
+
+	reportId, err := groups.CreateDiagnosis(fake.ServiceClient(), "instance-id", groups.DiagnosisOpts{
+		GroupName: "billing",
+		TopicName: "invoices",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "report-id", reportId)
+
+	report, err := groups.WaitForDiagnosis(fake.ServiceClient(), "instance-id", reportId, 10)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, report[0].AbnormalNum)
+	th.AssertEquals(t, "frequent_rebalance", report[0].Items[0].CauseIds[0].CauseId)
+}
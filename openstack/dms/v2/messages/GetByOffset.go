@@ -0,0 +1,47 @@
+package messages
+
+import (
+	"strconv"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type partitionMessageOpts struct {
+	MessageOffset string `q:"message_offset"`
+	Timestamp     string `q:"timestamp"`
+}
+
+// GetByOffset returns the message at the offset of the partition.
+func GetByOffset(client *golangsdk.ServiceClient, instanceId, topic string, partition int, offset int64) ([]Message, error) {
+	return getPartitionMessage(client, instanceId, topic, partition, partitionMessageOpts{
+		MessageOffset: strconv.FormatInt(offset, 10),
+	})
+}
+
+// GetByTimestamp returns the first message of the partition produced at or after the time,
+// in milliseconds since the epoch.
+func GetByTimestamp(client *golangsdk.ServiceClient, instanceId, topic string, partition int, timestamp int64) ([]Message, error) {
+	return getPartitionMessage(client, instanceId, topic, partition, partitionMessageOpts{
+		Timestamp: strconv.FormatInt(timestamp, 10),
+	})
+}
+
+func getPartitionMessage(client *golangsdk.ServiceClient, instanceId, topic string, partition int, opts partitionMessageOpts) ([]Message, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/management/topics/{topic}/partitions/{partition}/message
+	url, err := golangsdk.NewURLBuilder().
+		WithEndpoints("instances", instanceId, "management", "topics", topic, "partitions", strconv.Itoa(partition), "message").
+		WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Message
+	err = extract.IntoSlicePtr(raw.Body, &res, "message")
+	return res, err
+}
@@ -0,0 +1,43 @@
+package messages
+
+import (
+	"strconv"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// GetBeginningOffset returns the offset and time of the earliest message of the partition.
+func GetBeginningOffset(client *golangsdk.ServiceClient, instanceId, topic string, partition int) (*PartitionOffset, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/management/topics/{topic}/partitions/{partition}/beginning-message
+	return getPartitionOffset(client, instanceId, topic, partition, "beginning-message")
+}
+
+// GetEndOffset returns the offset and time of the latest message of the partition.
+func GetEndOffset(client *golangsdk.ServiceClient, instanceId, topic string, partition int) (*PartitionOffset, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/management/topics/{topic}/partitions/{partition}/end-message
+	return getPartitionOffset(client, instanceId, topic, partition, "end-message")
+}
+
+func getPartitionOffset(client *golangsdk.ServiceClient, instanceId, topic string, partition int, position string) (*PartitionOffset, error) {
+	url := client.ServiceURL("instances", instanceId, "management", "topics", topic, "partitions", strconv.Itoa(partition), position)
+	raw, err := client.Get(url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res PartitionOffset
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type PartitionOffset struct {
+	// Topic name.
+	Topic string `json:"topic"`
+	// Partition number.
+	Partition int `json:"partition"`
+	// Message offset.
+	MessageOffset int64 `json:"message_offset"`
+	// Production time of the message in milliseconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+}
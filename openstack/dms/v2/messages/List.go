@@ -0,0 +1,71 @@
+package messages
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListOpts struct {
+	// Topic name.
+	Topic string `q:"topic,required"`
+	// Start time of the period in milliseconds since the epoch.
+	StartTime int64 `q:"start_time"`
+	// End time of the period in milliseconds since the epoch.
+	EndTime int64 `q:"end_time"`
+	// Partition to query. All partitions are queried if not set.
+	Partition *int `q:"partition"`
+	// Offset of the message to query, used together with Partition.
+	MessageOffset *int64 `q:"message_offset"`
+	// Whether to sort the messages in ascending order of time.
+	Asc bool `q:"asc"`
+	// Offset of the first message to return.
+	Offset int `q:"offset"`
+	// Maximum number of messages to return.
+	Limit int `q:"limit"`
+	// Whether to return the full message bodies instead of the first bytes only.
+	Download bool `q:"download"`
+}
+
+// List returns the messages of the topic produced within the period, or the message at the offset
+// of the partition.
+func List(client *golangsdk.ServiceClient, instanceId string, opts ListOpts) (*ListResponse, error) {
+	// GET /v2/{project_id}/instances/{instance_id}/messages
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", instanceId, "messages").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	Messages []Message `json:"messages"`
+	Total    int       `json:"total"`
+	Size     int       `json:"size"`
+}
+
+type Message struct {
+	// Topic name.
+	Topic string `json:"topic"`
+	// Partition number.
+	Partition int `json:"partition"`
+	// Message offset.
+	MessageOffset int64 `json:"message_offset"`
+	// Message key.
+	Key string `json:"key"`
+	// Message body.
+	Value string `json:"value"`
+	// Message size in bytes.
+	Size int `json:"size"`
+	// Production time of the message in milliseconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+	// Whether the message body is too large to be returned in full.
+	HugeMessage bool `json:"huge_message"`
+}
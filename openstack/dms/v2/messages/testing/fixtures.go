@@ -0,0 +1,45 @@
+package testing
+
+const listResponse = `
+{
+    "messages": [
+        {
+            "topic": "invoices",
+            "partition": 0,
+            "message_offset": 42,
+            "key": "invoice-42",
+            "value": "{\"amount\": 10}",
+            "size": 14,
+            "timestamp": 1685606400000,
+            "huge_message": false
+        }
+    ],
+    "total": 1,
+    "size": 1
+}
+`
+
+const partitionMessageResponse = `
+{
+    "message": [
+        {
+            "topic": "invoices",
+            "partition": 1,
+            "message_offset": 0,
+            "key": "invoice-1",
+            "value": "{\"amount\": 5}",
+            "size": 13,
+            "timestamp": 1685600000000
+        }
+    ]
+}
+`
+
+const endMessageResponse = `
+{
+    "topic": "invoices",
+    "partition": 1,
+    "message_offset": 1000,
+    "timestamp": 1685606400000
+}
+`
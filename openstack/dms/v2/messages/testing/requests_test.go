@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/messages"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"topic":      "invoices",
+			"partition":  "0",
+			"start_time": "1685600000000",
+			"end_time":   "1685606400000",
+			"limit":      "10",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+
+	res, err := messages.List(fake.ServiceClient(), "instance-id", messages.ListOpts{
+		Topic:     "invoices",
+		Partition: pointerto.Int(0),
+		StartTime: 1685600000000,
+		EndTime:   1685606400000,
+		Limit:     10,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, res.Total)
+	th.AssertEquals(t, int64(42), res.Messages[0].MessageOffset)
+	th.AssertEquals(t, "invoice-42", res.Messages[0].Key)
+
+	_, err = messages.List(fake.ServiceClient(), "instance-id", messages.ListOpts{})
+	th.AssertEquals(t, "required query parameter [Topic] not set", err.Error())
+}
+
+func TestGetPartitionMessage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/management/topics/invoices/partitions/1/message", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		if r.URL.Query().Has("timestamp") {
+			th.TestFormValues(t, r, map[string]string{"timestamp": "1685600000000"})
+		} else {
+			th.TestFormValues(t, r, map[string]string{"message_offset": "0"})
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, partitionMessageResponse)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/management/topics/invoices/partitions/1/end-message", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, endMessageResponse)
+	})
+
+	byOffset, err := messages.GetByOffset(fake.ServiceClient(), "instance-id", "invoices", 1, 0)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "invoice-1", byOffset[0].Key)
+
+	byTime, err := messages.GetByTimestamp(fake.ServiceClient(), "instance-id", "invoices", 1, 1685600000000)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, byOffset, byTime)
+
+	end, err := messages.GetEndOffset(fake.ServiceClient(), "instance-id", "invoices", 1)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(1000), end.MessageOffset)
+}
@@ -1,6 +1,9 @@
 package topics
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
 	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
@@ -114,3 +117,91 @@ func Delete(client *golangsdk.ServiceClient, instanceID string, topics []string)
 	err = extract.Into(raw.Body, &res)
 	return &res, err
 }
+
+// ReassignOpts is a struct which represents the parameters of reassign function
+type ReassignOpts struct {
+	// Reassignments of the topics
+	Reassignments []Reassignment `json:"reassignments" required:"true"`
+	// Replication bandwidth limit in bytes per second, -1 for no limit
+	Throttle int `json:"throttle,omitempty"`
+	// Whether the reassignment is executed at ExecuteAt instead of immediately
+	IsSchedule bool `json:"is_schedule,omitempty"`
+	// Execution time of a scheduled reassignment in milliseconds since the epoch
+	ExecuteAt int64 `json:"execute_at,omitempty"`
+	// Whether to estimate the reassignment time only, without reassigning the partitions
+	TimeEstimate bool `json:"time_estimate,omitempty"`
+}
+
+// Reassignment represents the reassignment of one topic. Either Brokers or Assignment must be set.
+type Reassignment struct {
+	Topic string `json:"topic" required:"true"`
+	// Brokers the partitions are automatically reassigned to
+	Brokers []int `json:"brokers,omitempty"`
+	// Replication factor of the partitions, used with Brokers
+	ReplicationFactor int `json:"replication_factor,omitempty"`
+	// Manual assignment of the partitions
+	Assignment []PartitionAssignment `json:"assignment,omitempty"`
+}
+
+// PartitionAssignment represents the brokers of one partition, the first one is the preferred leader
+type PartitionAssignment struct {
+	Partition        int   `json:"partition"`
+	PartitionBrokers []int `json:"partition_brokers" required:"true"`
+}
+
+// Reassign reassigns the partitions of the topics to brokers, see WaitForReassignment
+func Reassign(client *golangsdk.ServiceClient, instanceID string, opts ReassignOpts) (*ReassignResponse, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Post(reassignURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ReassignResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+// GetTask returns the background task of the instance, e.g. the one reassigning partitions
+func GetTask(client *golangsdk.ServiceClient, instanceID, taskID string) (*Task, error) {
+	raw, err := client.Get(taskURL(client, instanceID, taskID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Task
+	err = extract.IntoSlicePtr(raw.Body, &res, "tasks")
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("task %s of instance %s not found", taskID, instanceID)
+	}
+	return &res[0], nil
+}
+
+// WaitForReassignment waits up to timeout seconds until the reassignment task succeeds
+func WaitForReassignment(client *golangsdk.ServiceClient, instanceID, jobID string, timeout int) error {
+	return golangsdk.WaitFor(timeout, func() (bool, error) {
+		task, err := GetTask(client, instanceID, jobID)
+		if err != nil {
+			return false, err
+		}
+
+		switch task.Status {
+		case "SUCCESS":
+			return true, nil
+		case "FAILED", "DELETED":
+			return false, fmt.Errorf("reassignment task %s of instance %s is %s", jobID, instanceID, task.Status)
+		}
+
+		time.Sleep(5 * time.Second)
+		return false, nil
+	})
+}
@@ -65,3 +65,24 @@ type DeleteResponse struct {
 	Name    string `json:"id"`
 	Success bool   `json:"success"`
 }
+
+// ReassignResponse is a struct that contains the reassign response
+type ReassignResponse struct {
+	// ID of the reassignment task, empty for time estimates
+	JobID string `json:"job_id"`
+	// ID of the scheduled reassignment
+	ScheduleID string `json:"schedule_id"`
+	// Estimated reassignment time in seconds, set for time estimates only
+	ReassignmentTime int `json:"reassignment_time"`
+}
+
+// Task represents a background task of an instance
+type Task struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Params string `json:"params"`
+	// Task status: CREATED, EXECUTING, SUCCESS, FAILED or DELETED
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
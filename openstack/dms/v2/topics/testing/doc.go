@@ -0,0 +1,2 @@
+// topics unit tests
+package testing
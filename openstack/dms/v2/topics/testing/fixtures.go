@@ -0,0 +1,40 @@
+package testing
+
+const reassignRequest = `
+{
+    "reassignments": [
+        {
+            "topic": "invoices",
+            "assignment": [
+                {
+                    "partition": 0,
+                    "partition_brokers": [1, 2]
+                },
+                {
+                    "partition": 1,
+                    "partition_brokers": [2, 0]
+                }
+            ]
+        }
+    ],
+    "throttle": 10485760
+}
+`
+
+const taskResponse = `
+{
+    "task_count": "1",
+    "tasks": [
+        {
+            "id": "job-id",
+            "name": "kafkaReassignment",
+            "user_name": "admin",
+            "user_id": "user-id",
+            "params": "",
+            "status": "%s",
+            "created_at": "2023-06-01T08:00:00.000Z",
+            "updated_at": "2023-06-01T08:01:00.000Z"
+        }
+    ]
+}
+`
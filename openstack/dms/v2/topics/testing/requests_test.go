@@ -0,0 +1,57 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/topics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestReassign(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/kafka/instances/instance-id/reassign", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, reassignRequest)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"job_id": "job-id"}`)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/tasks/job-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, taskResponse, "SUCCESS")
+	})
+
+	res, err := topics.Reassign(fake.ServiceClient(), "instance-id", topics.ReassignOpts{
+		Reassignments: []topics.Reassignment{{
+			Topic: "invoices",
+			Assignment: []topics.PartitionAssignment{
+				{Partition: 0, PartitionBrokers: []int{1, 2}},
+				{Partition: 1, PartitionBrokers: []int{2, 0}},
+			},
+		}},
+		Throttle: 10485760,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "job-id", res.JobID)
+
+	th.AssertNoErr(t, topics.WaitForReassignment(fake.ServiceClient(), "instance-id", res.JobID, 10))
+}
+
+func TestWaitForFailedReassignment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/tasks/job-id", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, taskResponse, "FAILED")
+	})
+
+	err := topics.WaitForReassignment(fake.ServiceClient(), "instance-id", "job-id", 10)
+	th.AssertEquals(t, "reassignment task job-id of instance instance-id is FAILED", err.Error())
+}
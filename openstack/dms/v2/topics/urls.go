@@ -23,3 +23,13 @@ func getURL(client *golangsdk.ServiceClient, instanceID, topic string) string {
 func deleteURL(client *golangsdk.ServiceClient, instanceID string) string {
 	return client.ServiceURL(resourcePath, instanceID, topicPath, "delete")
 }
+
+// reassignURL will build the url of reassign
+func reassignURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("kafka", resourcePath, instanceID, "reassign")
+}
+
+// taskURL will build the url of get task
+func taskURL(client *golangsdk.ServiceClient, instanceID, taskID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "tasks", taskID)
+}
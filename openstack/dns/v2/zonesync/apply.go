@@ -0,0 +1,172 @@
+package zonesync
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/multierr"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
+)
+
+// ApplyOpts controls how a plan is applied.
+type ApplyOpts struct {
+	// Concurrency is the maximum number of requests in flight. Defaults to 1.
+	Concurrency int
+
+	// DryRun only computes the plan, no changes are made.
+	DryRun bool
+}
+
+// Result contains the record sets changed by Apply.
+type Result struct {
+	Created []recordsets.RecordSet
+	Updated []recordsets.RecordSet
+	// Deleted contains IDs of the deleted record sets.
+	Deleted []string
+}
+
+// ListLive returns all record sets of the zone.
+func ListLive(client *golangsdk.ServiceClient, zoneID string) ([]recordsets.RecordSet, error) {
+	pages, err := recordsets.ListByZone(client, zoneID, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return recordsets.ExtractRecordSets(pages)
+}
+
+// Apply executes the plan against the zone. Record sets are deleted first,
+// so that a name can change its type (e.g. from CNAME to A), then updated and
+// created. Failed operations don't stop the remaining ones of the same stage;
+// all errors are returned together.
+func Apply(client *golangsdk.ServiceClient, zoneID string, plan *Plan, opts ApplyOpts) (*Result, error) {
+	result := &Result{}
+	if opts.DryRun || plan.IsEmpty() {
+		return result, nil
+	}
+
+	var mu sync.Mutex
+
+	err := runConcurrently(len(plan.Delete), opts.Concurrency, func(i int) error {
+		id := plan.Delete[i].ID
+		if err := recordsets.Delete(client, zoneID, id).ExtractErr(); err != nil {
+			return fmt.Errorf("error deleting record set %s (%s): %w", plan.Delete[i].Name, plan.Delete[i].Type, err)
+		}
+		mu.Lock()
+		result.Deleted = append(result.Deleted, id)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	var errs multierr.MultiError
+
+	err = runConcurrently(len(plan.Update), opts.Concurrency, func(i int) error {
+		u := plan.Update[i]
+		rs, err := recordsets.Update(client, zoneID, u.Current.ID, u.Opts).Extract()
+		if err != nil {
+			return fmt.Errorf("error updating record set %s (%s): %w", u.Current.Name, u.Current.Type, err)
+		}
+		mu.Lock()
+		result.Updated = append(result.Updated, *rs)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = runConcurrently(len(plan.Create), opts.Concurrency, func(i int) error {
+		c := plan.Create[i]
+		rs, err := recordsets.Create(client, zoneID, c).Extract()
+		if err != nil {
+			return fmt.Errorf("error creating record set %s (%s): %w", c.Name, c.Type, err)
+		}
+		mu.Lock()
+		result.Created = append(result.Created, *rs)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return result, errs.ErrorOrNil()
+}
+
+// Sync computes the plan converging the zone to the desired record sets and
+// applies it. The plan is returned in dry-run mode as well.
+func Sync(client *golangsdk.ServiceClient, zoneID string, desired []recordsets.CreateOpts, opts ApplyOpts) (*Plan, *Result, error) {
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+	live, err := ListLive(client, zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan := Diff(zone.Name, desired, live)
+	result, err := Apply(client, zoneID, plan, opts)
+	return plan, result, err
+}
+
+// Export writes all record sets of the zone in zone file format.
+func Export(client *golangsdk.ServiceClient, zoneID string, w io.Writer) error {
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return err
+	}
+	live, err := ListLive(client, zoneID)
+	if err != nil {
+		return err
+	}
+
+	sets := make([]recordsets.CreateOpts, len(live))
+	for i, rs := range live {
+		sets[i] = recordsets.CreateOpts{
+			Name:        rs.Name,
+			Description: rs.Description,
+			Records:     rs.Records,
+			TTL:         rs.TTL,
+			Type:        rs.Type,
+		}
+	}
+	return Write(w, zone.Name, sets)
+}
+
+// runConcurrently calls fn for indexes [0, n) using at most limit goroutines.
+func runConcurrently(n, limit int, fn func(i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs multierr.MultiError
+	)
+	sem := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
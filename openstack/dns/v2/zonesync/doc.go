@@ -0,0 +1,42 @@
+/*
+Package zonesync provides bulk synchronization of DNS record sets between
+RFC 1035 zone files and a zone of the DNS service.
+
+Both public and private zones are supported, as they share the same record
+set API.
+
+Example to Parse a Zone File
+
+	f, err := os.Open("example.com.zone")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	desired, err := zonesync.Parse(f, "example.com.")
+	if err != nil {
+		panic(err)
+	}
+
+Example to Synchronize a Zone in Dry-Run Mode
+
+	zoneID := "ff8080825b8fc86c015b94bc6f8712c3"
+
+	plan, _, err := zonesync.Sync(dnsClient, zoneID, desired, zonesync.ApplyOpts{
+		Concurrency: 8,
+		DryRun:      true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(plan)
+
+Example to Export a Zone
+
+	err := zonesync.Export(dnsClient, zoneID, os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+*/
+package zonesync
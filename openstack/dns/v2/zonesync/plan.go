@@ -0,0 +1,144 @@
+package zonesync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+)
+
+// Update describes a change of an existing record set.
+type Update struct {
+	// Current is the record set as it exists in the zone.
+	Current recordsets.RecordSet
+
+	// Opts contains the desired state of the record set.
+	Opts recordsets.UpdateOpts
+}
+
+// Plan is the minimal set of operations converging a zone to a desired state.
+type Plan struct {
+	Create []recordsets.CreateOpts
+	Update []Update
+	Delete []recordsets.RecordSet
+}
+
+// IsEmpty returns true if the zone is already in the desired state.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// String returns a human-readable representation of the plan.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, rs := range p.Delete {
+		fmt.Fprintf(&b, "- %s %s %s\n", rs.Name, rs.Type, strings.Join(rs.Records, ", "))
+	}
+	for _, u := range p.Update {
+		fmt.Fprintf(&b, "~ %s %s %s -> %s", u.Current.Name, u.Current.Type,
+			strings.Join(u.Current.Records, ", "), strings.Join(u.Opts.Records, ", "))
+		if u.Opts.TTL > 0 && u.Opts.TTL != u.Current.TTL {
+			fmt.Fprintf(&b, " (ttl %d -> %d)", u.Current.TTL, u.Opts.TTL)
+		}
+		b.WriteString("\n")
+	}
+	for _, opts := range p.Create {
+		fmt.Fprintf(&b, "+ %s %s %s\n", opts.Name, opts.Type, strings.Join(opts.Records, ", "))
+	}
+	return b.String()
+}
+
+// Diff compares the desired record sets with the record sets existing in the
+// zone named zoneName and returns the plan converging them.
+//
+// SOA and zone apex NS record sets are maintained by the DNS service and are
+// never part of the plan.
+func Diff(zoneName string, desired []recordsets.CreateOpts, live []recordsets.RecordSet) *Plan {
+	plan := &Plan{}
+
+	current := make(map[string]recordsets.RecordSet, len(live))
+	for _, rs := range live {
+		if isManaged(zoneName, rs.Name, rs.Type) {
+			current[setKey(rs.Name, rs.Type)] = rs
+		}
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, opts := range desired {
+		if !isManaged(zoneName, opts.Name, opts.Type) {
+			continue
+		}
+		key := setKey(opts.Name, opts.Type)
+		wanted[key] = true
+
+		rs, ok := current[key]
+		if !ok {
+			plan.Create = append(plan.Create, opts)
+			continue
+		}
+		if needsUpdate(opts, rs) {
+			plan.Update = append(plan.Update, Update{
+				Current: rs,
+				Opts: recordsets.UpdateOpts{
+					Description: opts.Description,
+					TTL:         opts.TTL,
+					Records:     opts.Records,
+				},
+			})
+		}
+	}
+
+	for _, rs := range live {
+		key := setKey(rs.Name, rs.Type)
+		if _, ok := current[key]; ok && !wanted[key] {
+			plan.Delete = append(plan.Delete, rs)
+		}
+	}
+
+	return plan
+}
+
+func isManaged(zoneName, name, rrType string) bool {
+	switch strings.ToUpper(rrType) {
+	case "SOA":
+		return false
+	case "NS":
+		return !strings.EqualFold(fqdn(name), fqdn(zoneName))
+	}
+	return true
+}
+
+// needsUpdate compares the desired record set with the existing one. Zero TTL
+// and empty description mean "keep the current value".
+func needsUpdate(opts recordsets.CreateOpts, rs recordsets.RecordSet) bool {
+	if opts.TTL > 0 && opts.TTL != rs.TTL {
+		return true
+	}
+	if opts.Description != "" && opts.Description != rs.Description {
+		return true
+	}
+	return !sameRecords(opts.Records, rs.Records)
+}
+
+func sameRecords(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x, y := normalizeRecords(a), normalizeRecords(b)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeRecords(records []string) []string {
+	result := make([]string, len(records))
+	for i, r := range records {
+		result[i] = strings.Join(strings.Fields(r), " ")
+	}
+	sort.Strings(result)
+	return result
+}
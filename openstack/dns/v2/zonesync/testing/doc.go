@@ -0,0 +1,2 @@
+// zonesync unit tests
+package testing
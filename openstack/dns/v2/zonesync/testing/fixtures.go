@@ -0,0 +1,152 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const zoneID = "2150b1bf-dee2-4221-9d85-11f7886fb15f"
+
+// ZoneFile is a sample zone file.
+const ZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA  ns1.example.com. admin.example.com. (
+                 2023010101 ; serial
+                 7200       ; refresh
+                 3600       ; retry
+                 1209600    ; expire
+                 300 )      ; minimum
+        IN  NS   ns1
+@       300 IN  A    192.0.2.1
+            IN  A    192.0.2.2
+www         CNAME    @
+mail    IN  MX   10 mx1
+txt         TXT  "v=spf1 include:example.net ~all" ; spf
+$ORIGIN sub.example.com.
+host    60  A    192.0.2.10
+`
+
+// GetZoneOutput is a sample response to a zone Get call.
+const GetZoneOutput = `
+{
+    "id": "2150b1bf-dee2-4221-9d85-11f7886fb15f",
+    "name": "example.com.",
+    "email": "joe@example.org",
+    "ttl": 7200,
+    "serial": 1404757531,
+    "status": "ACTIVE",
+    "zone_type": "private"
+}
+`
+
+// ListOutput is a sample response to a record set ListByZone call.
+const ListOutput = `
+{
+    "recordsets": [
+        {
+            "id": "soa",
+            "name": "example.com.",
+            "type": "SOA",
+            "ttl": 300,
+            "records": ["ns1.example.com. admin.example.com. 1 7200 900 1209600 300"]
+        },
+        {
+            "id": "ns",
+            "name": "example.com.",
+            "type": "NS",
+            "ttl": 172800,
+            "records": ["ns1.example.com."]
+        },
+        {
+            "id": "a",
+            "name": "example.com.",
+            "type": "A",
+            "ttl": 300,
+            "records": ["192.0.2.2", "192.0.2.1"]
+        },
+        {
+            "id": "www",
+            "name": "www.example.com.",
+            "type": "A",
+            "ttl": 300,
+            "records": ["192.0.2.1"]
+        },
+        {
+            "id": "mail",
+            "name": "mail.example.com.",
+            "type": "MX",
+            "ttl": 600,
+            "records": ["10 mx1.example.com."]
+        }
+    ],
+    "links": {},
+    "metadata": {"total_count": 5}
+}
+`
+
+// CreateWWWResponse is a sample response to a record set Create call.
+const CreateWWWResponse = `
+{
+    "id": "www-cname",
+    "name": "www.example.com.",
+    "type": "CNAME",
+    "ttl": 3600,
+    "records": ["example.com."]
+}
+`
+
+// UpdateMailResponse is a sample response to a record set Update call.
+const UpdateMailResponse = `
+{
+    "id": "mail",
+    "name": "mail.example.com.",
+    "type": "MX",
+    "ttl": 3600,
+    "records": ["10 mx1.example.com."]
+}
+`
+
+// HandleSyncSuccessfully configures the test server to respond to the calls
+// made by Sync and records deleted IDs.
+func HandleSyncSuccessfully(t *testing.T, deleted chan<- string) {
+	th.Mux.HandleFunc("/zones/"+zoneID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, GetZoneOutput)
+	})
+	th.Mux.HandleFunc("/zones/"+zoneID+"/recordsets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			_, _ = fmt.Fprint(w, ListOutput)
+		case "POST":
+			th.TestJSONRequest(t, r, `{"name": "www.example.com.", "type": "CNAME", "ttl": 3600, "records": ["example.com."]}`)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprint(w, CreateWWWResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	th.Mux.HandleFunc("/zones/"+zoneID+"/recordsets/mail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"ttl": 3600, "records": ["10 mx1.example.com."]}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, UpdateMailResponse)
+	})
+	th.Mux.HandleFunc("/zones/"+zoneID+"/recordsets/www", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		deleted <- "www"
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
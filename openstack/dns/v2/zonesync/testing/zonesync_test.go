@@ -0,0 +1,148 @@
+package testing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zonesync"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+var expectedSets = []recordsets.CreateOpts{
+	{
+		Name:    "example.com.",
+		Type:    "SOA",
+		TTL:     3600,
+		Records: []string{"ns1.example.com. admin.example.com. 2023010101 7200 3600 1209600 300"},
+	},
+	{
+		Name:    "example.com.",
+		Type:    "NS",
+		TTL:     3600,
+		Records: []string{"ns1.example.com."},
+	},
+	{
+		Name:    "example.com.",
+		Type:    "A",
+		TTL:     300,
+		Records: []string{"192.0.2.1", "192.0.2.2"},
+	},
+	{
+		Name:    "www.example.com.",
+		Type:    "CNAME",
+		TTL:     3600,
+		Records: []string{"example.com."},
+	},
+	{
+		Name:    "mail.example.com.",
+		Type:    "MX",
+		TTL:     3600,
+		Records: []string{"10 mx1.example.com."},
+	},
+	{
+		Name:    "txt.example.com.",
+		Type:    "TXT",
+		TTL:     3600,
+		Records: []string{`"v=spf1 include:example.net ~all"`},
+	},
+	{
+		Name:    "host.sub.example.com.",
+		Type:    "A",
+		TTL:     60,
+		Records: []string{"192.0.2.10"},
+	},
+}
+
+func TestParse(t *testing.T) {
+	actual, err := zonesync.Parse(strings.NewReader(ZoneFile), "example.com")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedSets, actual)
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"unclosed":  "@ IN SOA ns1 admin ( 1 2 3",
+		"no type":   "@ 300 IN",
+		"include":   "$INCLUDE other.zone",
+		"bad ttl":   "$TTL 1x",
+		"no owner":  "  IN A 192.0.2.1",
+		"no quotes": `txt TXT "unterminated`,
+	}
+	for name, input := range cases {
+		_, err := zonesync.Parse(strings.NewReader(input), "example.com.")
+		if err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	th.AssertNoErr(t, zonesync.Write(buf, "example.com.", expectedSets))
+	th.AssertEquals(t, true, strings.Contains(buf.String(), "www\t3600\tIN\tCNAME\texample.com.\n"))
+
+	actual, err := zonesync.Parse(buf, "example.com.")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedSets, actual)
+}
+
+func TestDiff(t *testing.T) {
+	live := []recordsets.RecordSet{
+		{ID: "soa", Name: "example.com.", Type: "SOA", TTL: 300},
+		{ID: "ns", Name: "example.com.", Type: "NS", TTL: 172800, Records: []string{"ns1.example.com."}},
+		{ID: "a", Name: "example.com.", Type: "A", TTL: 300, Records: []string{"192.0.2.2", "192.0.2.1"}},
+		{ID: "old", Name: "old.example.com.", Type: "A", TTL: 300, Records: []string{"192.0.2.3"}},
+		{ID: "mail", Name: "MAIL.example.com.", Type: "MX", TTL: 600, Records: []string{"10 mx1.example.com."}},
+	}
+	desired := []recordsets.CreateOpts{
+		{Name: "example.com.", Type: "SOA", Records: []string{"ignored"}},
+		{Name: "example.com.", Type: "A", TTL: 300, Records: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "mail.example.com.", Type: "MX", TTL: 3600, Records: []string{"10 mx1.example.com."}},
+		{Name: "new.example.com.", Type: "A", Records: []string{"192.0.2.4"}},
+	}
+
+	plan := zonesync.Diff("example.com.", desired, live)
+	th.CheckDeepEquals(t, []recordsets.CreateOpts{desired[3]}, plan.Create)
+	th.AssertEquals(t, 1, len(plan.Update))
+	th.CheckEquals(t, "mail", plan.Update[0].Current.ID)
+	th.CheckEquals(t, 3600, plan.Update[0].Opts.TTL)
+	th.AssertEquals(t, 1, len(plan.Delete))
+	th.CheckEquals(t, "old", plan.Delete[0].ID)
+	th.CheckEquals(t, false, plan.IsEmpty())
+
+	noop := zonesync.Diff("example.com.", desired[:2], live[:3])
+	th.CheckEquals(t, true, noop.IsEmpty())
+}
+
+func TestSync(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	deleted := make(chan string, 1)
+	HandleSyncSuccessfully(t, deleted)
+
+	desired, err := zonesync.Parse(strings.NewReader(ZoneFile), "example.com.")
+	th.AssertNoErr(t, err)
+	// only keep apex A, www CNAME and mail MX
+	desired = desired[2:5]
+
+	plan, result, err := zonesync.Sync(client.ServiceClient(), zoneID, desired, zonesync.ApplyOpts{DryRun: true})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, len(plan.Create))
+	th.CheckEquals(t, 1, len(plan.Update))
+	th.CheckEquals(t, 1, len(plan.Delete))
+	th.CheckEquals(t, 0, len(result.Created))
+	th.CheckEquals(t, 0, len(deleted))
+
+	plan, result, err = zonesync.Sync(client.ServiceClient(), zoneID, desired, zonesync.ApplyOpts{Concurrency: 4})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "www", <-deleted)
+	th.CheckDeepEquals(t, []string{"www"}, result.Deleted)
+	th.CheckEquals(t, "www-cname", result.Created[0].ID)
+	th.CheckEquals(t, 3600, result.Updated[0].TTL)
+	th.CheckEquals(t, "- www.example.com. A 192.0.2.1\n"+
+		"~ mail.example.com. MX 10 mx1.example.com. -> 10 mx1.example.com. (ttl 600 -> 3600)\n"+
+		"+ www.example.com. CNAME example.com.\n", plan.String())
+}
@@ -0,0 +1,356 @@
+package zonesync
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
+)
+
+// ParseError describes a problem found in a zone file.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("zone file line %d: %s", e.Line, e.Msg)
+}
+
+// logicalLine is a zone file entry after comments are stripped and
+// parenthesized continuations are joined.
+type logicalLine struct {
+	number     int
+	tokens     []string
+	blankOwner bool
+}
+
+// Parse reads an RFC 1035 zone file and returns its records grouped into
+// record sets. Relative names, including names inside CNAME, NS, MX, PTR and
+// SRV data, are qualified with origin, which can be overridden by `$ORIGIN`
+// directives. `$INCLUDE` is not supported.
+func Parse(r io.Reader, origin string) ([]recordsets.CreateOpts, error) {
+	lines, err := readLogicalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = fqdn(origin)
+	var (
+		defaultTTL int
+		lastTTL    int
+		lastOwner  string
+		result     []recordsets.CreateOpts
+	)
+	index := make(map[string]int)
+
+	for _, line := range lines {
+		tokens := line.tokens
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, &ParseError{Line: line.number, Msg: "$ORIGIN requires exactly one argument"}
+			}
+			origin = qualify(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, &ParseError{Line: line.number, Msg: "$TTL requires exactly one argument"}
+			}
+			ttl, err := parseTTL(tokens[1])
+			if err != nil {
+				return nil, &ParseError{Line: line.number, Msg: err.Error()}
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE":
+			return nil, &ParseError{Line: line.number, Msg: "$INCLUDE is not supported"}
+		}
+
+		owner := lastOwner
+		if !line.blankOwner {
+			owner = qualify(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, &ParseError{Line: line.number, Msg: "record without owner name"}
+		}
+
+		ttl, ttlSet := 0, false
+		var rrType string
+		for len(tokens) > 0 {
+			tok := tokens[0]
+			if isClass(tok) {
+				tokens = tokens[1:]
+				continue
+			}
+			if !ttlSet && len(tok) > 0 && unicode.IsDigit(rune(tok[0])) {
+				v, err := parseTTL(tok)
+				if err != nil {
+					return nil, &ParseError{Line: line.number, Msg: err.Error()}
+				}
+				ttl, ttlSet = v, true
+				tokens = tokens[1:]
+				continue
+			}
+			rrType = strings.ToUpper(tok)
+			tokens = tokens[1:]
+			break
+		}
+		if rrType == "" {
+			return nil, &ParseError{Line: line.number, Msg: "missing record type"}
+		}
+		if len(tokens) == 0 {
+			return nil, &ParseError{Line: line.number, Msg: fmt.Sprintf("missing data for %s record", rrType)}
+		}
+
+		switch {
+		case ttlSet:
+		case defaultTTL > 0:
+			ttl = defaultTTL
+		default:
+			ttl = lastTTL
+		}
+		lastOwner, lastTTL = owner, ttl
+
+		data := strings.Join(qualifyData(rrType, tokens, origin), " ")
+		key := setKey(owner, rrType)
+		if i, ok := index[key]; ok {
+			result[i].Records = append(result[i].Records, data)
+			continue
+		}
+		index[key] = len(result)
+		result = append(result, recordsets.CreateOpts{
+			Name:    owner,
+			Type:    rrType,
+			TTL:     ttl,
+			Records: []string{data},
+		})
+	}
+
+	return result, nil
+}
+
+// Write emits record sets in zone file format. Names below origin are
+// written relative to it.
+func Write(w io.Writer, origin string, sets []recordsets.CreateOpts) error {
+	origin = fqdn(origin)
+	bw := bufio.NewWriter(w)
+	if _, err := fmt.Fprintf(bw, "$ORIGIN %s\n", origin); err != nil {
+		return err
+	}
+	for _, set := range sets {
+		name := relativeName(set.Name, origin)
+		ttl := ""
+		if set.TTL > 0 {
+			ttl = strconv.Itoa(set.TTL)
+		}
+		for _, record := range set.Records {
+			if _, err := fmt.Fprintf(bw, "%s\t%s\tIN\t%s\t%s\n", name, ttl, strings.ToUpper(set.Type), record); err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+func readLogicalLines(r io.Reader) ([]logicalLine, error) {
+	var (
+		lines   []logicalLine
+		current logicalLine
+		depth   int
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := scanner.Text()
+		if depth == 0 {
+			current = logicalLine{
+				number:     number,
+				blankOwner: len(text) > 0 && (text[0] == ' ' || text[0] == '\t'),
+			}
+		}
+
+		tokens, delta, err := tokenize(text)
+		if err != nil {
+			return nil, &ParseError{Line: number, Msg: err.Error()}
+		}
+		current.tokens = append(current.tokens, tokens...)
+		depth += delta
+		if depth < 0 {
+			return nil, &ParseError{Line: number, Msg: "unbalanced parentheses"}
+		}
+		if depth == 0 && len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, &ParseError{Line: current.number, Msg: "unclosed parentheses"}
+	}
+	return lines, nil
+}
+
+// tokenize splits a physical line into whitespace separated tokens, keeping
+// quoted strings intact and dropping comments and parentheses. It returns
+// the change of the parentheses depth.
+func tokenize(text string) ([]string, int, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		quoted  bool
+		escaped bool
+		depth   int
+	)
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, c := range text {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			current.WriteRune(c)
+			escaped = true
+		case c == '"':
+			current.WriteRune(c)
+			quoted = !quoted
+		case quoted:
+			current.WriteRune(c)
+		case c == ';':
+			flush()
+			return tokens, depth, nil
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			depth--
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			current.WriteRune(c)
+		}
+	}
+	if quoted {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+	return tokens, depth, nil
+}
+
+// parseTTL parses a TTL in seconds or in BIND duration notation, e.g. `1h30m`.
+func parseTTL(s string) (int, error) {
+	if v, err := strconv.Atoi(s); err == nil {
+		return v, nil
+	}
+
+	total, value := 0, 0
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			value = value*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		switch c {
+		case 's':
+		case 'm':
+			value *= 60
+		case 'h':
+			value *= 3600
+		case 'd':
+			value *= 86400
+		case 'w':
+			value *= 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += value
+		value, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return total, nil
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// qualifyData qualifies domain names found in the record data of well-known
+// record types.
+func qualifyData(rrType string, tokens []string, origin string) []string {
+	position := -1
+	switch rrType {
+	case "CNAME", "NS", "PTR":
+		position = 0
+	case "MX":
+		position = 1
+	case "SRV":
+		position = 3
+	}
+	if position < 0 || position >= len(tokens) {
+		return tokens
+	}
+	result := make([]string, len(tokens))
+	copy(result, tokens)
+	result[position] = qualify(result[position], origin)
+	return result
+}
+
+func qualify(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+func relativeName(name, origin string) string {
+	name = fqdn(name)
+	if strings.EqualFold(name, origin) {
+		return "@"
+	}
+	if suffix := "." + origin; len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)]
+	}
+	return name
+}
+
+func fqdn(name string) string {
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func setKey(name, rrType string) string {
+	return strings.ToLower(fqdn(name)) + " " + strings.ToUpper(rrType)
+}
@@ -2,8 +2,10 @@ package cloudservers
 
 import (
 	"encoding/base64"
+	"strings"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type CreateOpts struct {
@@ -272,6 +274,84 @@ func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 	return
 }
 
+// defaultListLimit is the page size used by the API when no limit is given.
+const defaultListLimit = 25
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToServerListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API.
+type ListOpts struct {
+	// Name of the server as a string; can be queried with regular expressions.
+	Name string `q:"name"`
+
+	// Flavor is the ID of the flavor.
+	Flavor string `q:"flavor"`
+
+	// Status is the value of the status of the server.
+	Status string `q:"status"`
+
+	// Tags lists the tags the servers must have, in the `key=value` format.
+	Tags []string
+
+	// NotTags lists the tags the servers must not have, in the `key=value` format.
+	NotTags []string
+
+	// EnterpriseProjectID is the ID of the enterprise project.
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+
+	// IP is the IPv4 address of the server, matched fuzzily.
+	IP string `q:"ip"`
+
+	// IPEq is the IPv4 address of the server, matched exactly.
+	IPEq string `q:"ip_eq"`
+
+	// ReservationID is the reservation ID returned by a batch create.
+	ReservationID string `q:"reservation_id"`
+
+	// Limit is the number of servers on a page, 25 by default, 1000 at most.
+	Limit int `q:"limit"`
+
+	// Offset is the number of the page to start from, starting at 1.
+	Offset int `q:"offset"`
+}
+
+// ToServerListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServerListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	params := q.Query()
+	if len(opts.Tags) > 0 {
+		params.Set("tags", strings.Join(opts.Tags, ","))
+	}
+	if len(opts.NotTags) > 0 {
+		params.Set("not-tags", strings.Join(opts.NotTags, ","))
+	}
+	q.RawQuery = params.Encode()
+	return q.String(), nil
+}
+
+// List makes a request against the API to list servers accessible to you.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listDetailURL(client)
+	if opts != nil {
+		query, err := opts.ToServerListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServerPage{pagination.OffsetPageBase{PageResult: r}}
+	})
+}
+
 type DeleteOpts struct {
 	// Servers to be deleted
 	Servers []Server `json:"servers" required:"true"`
@@ -304,3 +384,232 @@ func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r JobResult) {
 	_, r.Err = client.Post(deleteURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
 	return
 }
+
+// StopType is the type of batch stop or reboot operation.
+type StopType string
+
+const (
+	// Soft stops or reboots the ECSs normally.
+	Soft StopType = "SOFT"
+	// Hard forcibly stops or reboots the ECSs.
+	Hard StopType = "HARD"
+)
+
+type BatchStartOpts struct {
+	// Servers to be started
+	Servers []Server `json:"servers" required:"true"`
+}
+
+// BatchStart starts ECSs in a batch.
+func BatchStart(client *golangsdk.ServiceClient, opts BatchStartOpts) (r JobResult) {
+	return doAction(client, opts, "os-start")
+}
+
+type BatchStopOpts struct {
+	// Servers to be stopped
+	Servers []Server `json:"servers" required:"true"`
+
+	// Type specifies the stop type. The default value is Soft.
+	Type StopType `json:"type,omitempty"`
+}
+
+// BatchStop stops ECSs in a batch.
+func BatchStop(client *golangsdk.ServiceClient, opts BatchStopOpts) (r JobResult) {
+	return doAction(client, opts, "os-stop")
+}
+
+type BatchRebootOpts struct {
+	// Servers to be rebooted
+	Servers []Server `json:"servers" required:"true"`
+
+	// Type specifies the reboot type.
+	Type StopType `json:"type" required:"true"`
+}
+
+// BatchReboot restarts ECSs in a batch.
+func BatchReboot(client *golangsdk.ServiceClient, opts BatchRebootOpts) (r JobResult) {
+	return doAction(client, opts, "reboot")
+}
+
+func doAction(client *golangsdk.ServiceClient, opts interface{}, action string) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, action)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type ResizeOpts struct {
+	// FlavorRef specifies the ID of the new flavor.
+	FlavorRef string `json:"flavorRef" required:"true"`
+
+	// DedicatedHostID specifies the new DeH ID, which is applicable only to ECSs on DeHs.
+	DedicatedHostID string `json:"dedicated_host_id,omitempty"`
+}
+
+// ToServerResizeMap assembles a request body based on the contents of a
+// ResizeOpts.
+func (opts ResizeOpts) ToServerResizeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "resize")
+}
+
+// Resize changes the flavor of an ECS.
+func Resize(client *golangsdk.ServiceClient, serverID string, opts ResizeOpts) (r JobResult) {
+	b, err := opts.ToServerResizeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(resizeURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type ChangeOSOpts struct {
+	// AdminPass specifies the initial password of the ECS administrator.
+	// Either AdminPass or KeyName must be set.
+	AdminPass string `json:"adminpass,omitempty"`
+
+	// KeyName specifies the key pair name.
+	KeyName string `json:"keyname,omitempty"`
+
+	// UserID specifies the user ID, required when KeyName is set.
+	UserID string `json:"userid,omitempty"`
+
+	// ImageID specifies the ID of the new image.
+	ImageID string `json:"imageid" required:"true"`
+}
+
+// ToServerChangeOSMap assembles a request body based on the contents of a
+// ChangeOSOpts.
+func (opts ChangeOSOpts) ToServerChangeOSMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "os-change")
+}
+
+// ChangeOS changes the OS of an ECS. The ECS must be stopped.
+func ChangeOS(client *golangsdk.ServiceClient, serverID string, opts ChangeOSOpts) (r JobResult) {
+	b, err := opts.ToServerChangeOSMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(changeOSURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type ReinstallOSOpts struct {
+	// AdminPass specifies the initial password of the ECS administrator.
+	// Either AdminPass or KeyName must be set.
+	AdminPass string `json:"adminpass,omitempty"`
+
+	// KeyName specifies the key pair name.
+	KeyName string `json:"keyname,omitempty"`
+
+	// UserID specifies the user ID, required when KeyName is set.
+	UserID string `json:"userid,omitempty"`
+}
+
+// ToServerReinstallOSMap assembles a request body based on the contents of a
+// ReinstallOSOpts.
+func (opts ReinstallOSOpts) ToServerReinstallOSMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "os-reinstall")
+}
+
+// ReinstallOS reinstalls the current OS of an ECS. The ECS must be stopped.
+func ReinstallOS(client *golangsdk.ServiceClient, serverID string, opts ReinstallOSOpts) (r JobResult) {
+	b, err := opts.ToServerReinstallOSMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(reinstallOSURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type AddNicsOpts struct {
+	// Nics specifies the NICs to be added.
+	Nics []AddNic `json:"nics" required:"true"`
+}
+
+type AddNic struct {
+	// SubnetId specifies the ID of the subnet (network ID) of the NIC.
+	SubnetId string `json:"subnet_id" required:"true"`
+
+	// SecurityGroups specifies the security groups of the NIC.
+	SecurityGroups []SecurityGroup `json:"security_groups,omitempty"`
+
+	// IpAddress specifies the IP address of the NIC. Assigned automatically if not set.
+	IpAddress string `json:"ip_address,omitempty"`
+}
+
+// AddNics attaches NICs to an ECS.
+func AddNics(client *golangsdk.ServiceClient, serverID string, opts AddNicsOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(nicsURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type DeleteNicsOpts struct {
+	// Nics specifies the NICs to be detached.
+	Nics []DeleteNic `json:"nics" required:"true"`
+}
+
+type DeleteNic struct {
+	// Id specifies the port ID of the NIC.
+	Id string `json:"id" required:"true"`
+}
+
+// DeleteNics detaches NICs from an ECS. The primary NIC can't be detached.
+func DeleteNics(client *golangsdk.ServiceClient, serverID string, opts DeleteNicsOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(deleteNicsURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type AttachVolumeOpts struct {
+	// VolumeId specifies the ID of the disk to be attached.
+	VolumeId string `json:"volumeId" required:"true"`
+
+	// Device specifies the mount point, such as /dev/sda. Assigned automatically if not set.
+	Device string `json:"device,omitempty"`
+}
+
+// AttachVolume attaches a disk to an ECS.
+func AttachVolume(client *golangsdk.ServiceClient, serverID string, opts AttachVolumeOpts) (r JobResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "volumeAttachment")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(attachVolumeURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+type DetachVolumeOpts struct {
+	// DeleteFlag specifies whether to forcibly detach a data disk,
+	// 0 (default) means no, 1 means yes.
+	DeleteFlag int `q:"delete_flag"`
+}
+
+// DetachVolume detaches a disk from an ECS.
+func DetachVolume(client *golangsdk.ServiceClient, serverID, volumeID string, opts DetachVolumeOpts) (r JobResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Delete(detachVolumeURL(client, serverID, volumeID)+q.String(), &golangsdk.RequestOpts{
+		OkCodes:      []int{200},
+		JSONResponse: &r.Body,
+	})
+	return
+}
@@ -1,9 +1,12 @@
 package cloudservers
 
 import (
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type cloudServerResult struct {
@@ -121,6 +124,8 @@ type CloudServer struct {
 	HypervisorHostname string               `json:"OS-EXT-SRV-ATTR:hypervisor_hostname"`
 	VolumeAttached     []VolumeAttached     `json:"os-extended-volumes:volumes_attached"`
 	OsSchedulerHints   OsSchedulerHints     `json:"os:scheduler_hints"`
+
+	EnterpriseProjectID string `json:"enterprise_project_id"`
 }
 
 // NewCloudServer defines the response from details on a single server, by ID.
@@ -146,3 +151,52 @@ func (r GetResult) Extract() (*CloudServer, error) {
 type DryRunResult struct {
 	golangsdk.ErrResult
 }
+
+// ServerPage is a single page of CloudServer results. The `offset` of the
+// ECS API is a page number, so the page is advanced by one while it's full.
+type ServerPage struct {
+	pagination.OffsetPageBase
+}
+
+// IsEmpty returns true if a page contains no CloudServer results.
+func (r ServerPage) IsEmpty() (bool, error) {
+	s, err := ExtractServers(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL returns the URL of the next page, if the current one is full.
+func (r ServerPage) NextPageURL() (string, error) {
+	s, err := ExtractServers(r)
+	if err != nil {
+		return "", err
+	}
+
+	q := r.URL.Query()
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil {
+		limit = defaultListLimit
+	}
+	if len(s) < limit {
+		return "", nil
+	}
+	offset, err := strconv.Atoi(q.Get("offset"))
+	if err != nil || offset < 1 {
+		offset = 1
+	}
+	q.Set("offset", strconv.Itoa(offset+1))
+	q.Set("limit", strconv.Itoa(limit))
+
+	next := url.URL(r.URL)
+	next.RawQuery = q.Encode()
+	return next.String(), nil
+}
+
+// ExtractServers interprets the results of a single page from a List() call,
+// producing a slice of CloudServer entities.
+func ExtractServers(r pagination.Page) ([]CloudServer, error) {
+	var s struct {
+		Servers []CloudServer `json:"servers"`
+	}
+	err := (r.(ServerPage)).ExtractInto(&s)
+	return s.Servers, err
+}
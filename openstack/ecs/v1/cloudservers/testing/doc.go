@@ -0,0 +1,2 @@
+// cloudservers unit tests
+package testing
@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+// serverList emulates the server listing of ECS, where the offset is the number of the page
+// and the limit defaults to 25.
+type serverList struct {
+	mu      sync.Mutex
+	total   int
+	queries []map[string]string
+}
+
+func handleListServers(t *testing.T, total int) *serverList {
+	list := &serverList{total: total}
+	th.Mux.HandleFunc("/cloudservers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		query := make(map[string]string)
+		for key := range r.URL.Query() {
+			query[key] = r.URL.Query().Get(key)
+		}
+		list.mu.Lock()
+		list.queries = append(list.queries, query)
+		list.mu.Unlock()
+
+		limit := 25
+		if v := query["limit"]; v != "" {
+			limit, _ = strconv.Atoi(v)
+		}
+		page := 1
+		if v := query["offset"]; v != "" {
+			page, _ = strconv.Atoi(v)
+		}
+
+		var servers []string
+		for i := (page - 1) * limit; i < page*limit && i < list.total; i++ {
+			servers = append(servers, fmt.Sprintf(`{"id": "server-%d", "name": "ecs-%d"}`, i, i))
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"count": %d, "servers": [%s]}`, list.total, strings.Join(servers, ","))
+	})
+	return list
+}
+
+// Queries returns the values of the query parameter key of all requests.
+func (l *serverList) Queries(key string) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	values := make([]string, len(l.queries))
+	for i, query := range l.queries {
+		values[i] = query[key]
+	}
+	return values
+}
+
+const jobResponse = `{"job_id": "70a599e0-31e7-49b7-b260-868f441e862b"}`
+
+// handleJob expects a request with the JSON body request, or without a body if request is
+// empty, and answers with a job.
+func handleJob(t *testing.T, path, method, request string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if request != "" {
+			th.TestJSONRequest(t, r, request)
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+const batchStartRequest = `
+{
+    "os-start": {
+        "servers": [
+            {"id": "server-1"},
+            {"id": "server-2"}
+        ]
+    }
+}
+`
+
+const batchStopRequest = `
+{
+    "os-stop": {
+        "servers": [
+            {"id": "server-1"}
+        ],
+        "type": "HARD"
+    }
+}
+`
+
+const batchRebootRequest = `
+{
+    "reboot": {
+        "servers": [
+            {"id": "server-1"}
+        ],
+        "type": "SOFT"
+    }
+}
+`
+
+const resizeRequest = `
+{
+    "resize": {
+        "flavorRef": "s3.large.2",
+        "dedicated_host_id": "deh-id"
+    }
+}
+`
+
+const changeOSRequest = `
+{
+    "os-change": {
+        "keyname": "my-key",
+        "userid": "user-id",
+        "imageid": "image-id"
+    }
+}
+`
+
+const reinstallOSRequest = `
+{
+    "os-reinstall": {
+        "adminpass": "Secret!Pass1"
+    }
+}
+`
+
+const addNicsRequest = `
+{
+    "nics": [
+        {
+            "subnet_id": "subnet-id",
+            "security_groups": [
+                {"id": "sg-id"}
+            ],
+            "ip_address": "192.168.0.10"
+        }
+    ]
+}
+`
+
+const deleteNicsRequest = `
+{
+    "nics": [
+        {"id": "port-id"}
+    ]
+}
+`
+
+const attachVolumeRequest = `
+{
+    "volumeAttachment": {
+        "volumeId": "volume-id",
+        "device": "/dev/sdb"
+    }
+}
+`
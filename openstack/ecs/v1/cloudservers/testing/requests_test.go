@@ -0,0 +1,208 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ecs/v1/cloudservers"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func listServerIDs(t *testing.T, opts cloudservers.ListOpts) ([]string, int) {
+	var ids []string
+	pages := 0
+	err := cloudservers.List(fake.ServiceClient(), opts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		servers, err := cloudservers.ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		for _, server := range servers {
+			ids = append(ids, server.ID)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	return ids, pages
+}
+
+func TestListPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	list := handleListServers(t, 5)
+
+	ids, pages := listServerIDs(t, cloudservers.ListOpts{Limit: 2, Offset: 1})
+	th.AssertDeepEquals(t, []string{"server-0", "server-1", "server-2", "server-3", "server-4"}, ids)
+	// the short third page ends the listing
+	th.AssertEquals(t, 3, pages)
+	th.AssertDeepEquals(t, []string{"1", "2", "3"}, list.Queries("offset"))
+	th.AssertDeepEquals(t, []string{"2", "2", "2"}, list.Queries("limit"))
+}
+
+func TestListPagesWithoutOffset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	list := handleListServers(t, 4)
+
+	ids, pages := listServerIDs(t, cloudservers.ListOpts{Limit: 2})
+	th.AssertEquals(t, 4, len(ids))
+	// the empty page after the full ones ends the listing
+	th.AssertEquals(t, 2, pages)
+	th.AssertDeepEquals(t, []string{"", "2", "3"}, list.Queries("offset"))
+}
+
+func TestListDefaultLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	list := handleListServers(t, 30)
+
+	ids, pages := listServerIDs(t, cloudservers.ListOpts{})
+	th.AssertEquals(t, 30, len(ids))
+	th.AssertEquals(t, "server-29", ids[29])
+	th.AssertEquals(t, 2, pages)
+	th.AssertDeepEquals(t, []string{"", "25"}, list.Queries("limit"))
+	th.AssertDeepEquals(t, []string{"", "2"}, list.Queries("offset"))
+}
+
+func TestListTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	list := handleListServers(t, 3)
+
+	opts := cloudservers.ListOpts{
+		Name:    "ecs",
+		Tags:    []string{"env=prod", "team=core"},
+		NotTags: []string{"temp=true"},
+		Limit:   2,
+	}
+	query, err := opts.ToServerListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?limit=2&name=ecs&not-tags=temp%3Dtrue&tags=env%3Dprod%2Cteam%3Dcore", query)
+
+	ids, _ := listServerIDs(t, opts)
+	th.AssertEquals(t, 3, len(ids))
+	th.AssertDeepEquals(t, []string{"env=prod,team=core", "env=prod,team=core"}, list.Queries("tags"))
+	th.AssertDeepEquals(t, []string{"temp=true", "temp=true"}, list.Queries("not-tags"))
+
+	query, err = cloudservers.ListOpts{}.ToServerListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", query)
+}
+
+const jobID = "70a599e0-31e7-49b7-b260-868f441e862b"
+
+func assertJob(t *testing.T, r cloudservers.JobResult) {
+	job, err := r.ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestBatchActions(t *testing.T) {
+	servers := []cloudservers.Server{{Id: "server-1"}}
+	cases := map[string]struct {
+		request string
+		action  func() cloudservers.JobResult
+	}{
+		"start": {batchStartRequest, func() cloudservers.JobResult {
+			return cloudservers.BatchStart(fake.ServiceClient(), cloudservers.BatchStartOpts{
+				Servers: []cloudservers.Server{{Id: "server-1"}, {Id: "server-2"}},
+			})
+		}},
+		"stop": {batchStopRequest, func() cloudservers.JobResult {
+			return cloudservers.BatchStop(fake.ServiceClient(), cloudservers.BatchStopOpts{Servers: servers, Type: cloudservers.Hard})
+		}},
+		"reboot": {batchRebootRequest, func() cloudservers.JobResult {
+			return cloudservers.BatchReboot(fake.ServiceClient(), cloudservers.BatchRebootOpts{Servers: servers, Type: cloudservers.Soft})
+		}},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			th.SetupHTTP()
+			defer th.TeardownHTTP()
+			handleJob(t, "/cloudservers/action", "POST", c.request)
+
+			assertJob(t, c.action())
+		})
+	}
+
+	// the reboot type is required
+	err := cloudservers.BatchReboot(fake.ServiceClient(), cloudservers.BatchRebootOpts{Servers: servers}).Err
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleJob(t, "/cloudservers/server-id/resize", "POST", resizeRequest)
+
+	assertJob(t, cloudservers.Resize(fake.ServiceClient(), "server-id", cloudservers.ResizeOpts{
+		FlavorRef:       "s3.large.2",
+		DedicatedHostID: "deh-id",
+	}))
+
+	err := cloudservers.Resize(fake.ServiceClient(), "server-id", cloudservers.ResizeOpts{}).Err
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestChangeOS(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleJob(t, "/cloudservers/server-id/changeos", "POST", changeOSRequest)
+	handleJob(t, "/cloudservers/server-id/reinstallos", "POST", reinstallOSRequest)
+
+	assertJob(t, cloudservers.ChangeOS(fake.ServiceClient(), "server-id", cloudservers.ChangeOSOpts{
+		KeyName: "my-key",
+		UserID:  "user-id",
+		ImageID: "image-id",
+	}))
+	assertJob(t, cloudservers.ReinstallOS(fake.ServiceClient(), "server-id", cloudservers.ReinstallOSOpts{
+		AdminPass: "Secret!Pass1",
+	}))
+
+	err := cloudservers.ChangeOS(fake.ServiceClient(), "server-id", cloudservers.ChangeOSOpts{KeyName: "my-key"}).Err
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestNics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleJob(t, "/cloudservers/server-id/nics", "POST", addNicsRequest)
+	handleJob(t, "/cloudservers/server-id/nics/delete", "POST", deleteNicsRequest)
+
+	assertJob(t, cloudservers.AddNics(fake.ServiceClient(), "server-id", cloudservers.AddNicsOpts{
+		Nics: []cloudservers.AddNic{{
+			SubnetId:       "subnet-id",
+			SecurityGroups: []cloudservers.SecurityGroup{{ID: "sg-id"}},
+			IpAddress:      "192.168.0.10",
+		}},
+	}))
+	assertJob(t, cloudservers.DeleteNics(fake.ServiceClient(), "server-id", cloudservers.DeleteNicsOpts{
+		Nics: []cloudservers.DeleteNic{{Id: "port-id"}},
+	}))
+}
+
+func TestVolumes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleJob(t, "/cloudservers/server-id/attachvolume", "POST", attachVolumeRequest)
+
+	assertJob(t, cloudservers.AttachVolume(fake.ServiceClient(), "server-id", cloudservers.AttachVolumeOpts{
+		VolumeId: "volume-id",
+		Device:   "/dev/sdb",
+	}))
+
+	var queries []string
+	th.Mux.HandleFunc("/cloudservers/server-id/detachvolume/volume-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+	assertJob(t, cloudservers.DetachVolume(fake.ServiceClient(), "server-id", "volume-id", cloudservers.DetachVolumeOpts{}))
+	assertJob(t, cloudservers.DetachVolume(fake.ServiceClient(), "server-id", "volume-id", cloudservers.DetachVolumeOpts{DeleteFlag: 1}))
+	th.AssertDeepEquals(t, []string{"", "delete_flag=1"}, queries)
+}
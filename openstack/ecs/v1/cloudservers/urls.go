@@ -22,3 +22,39 @@ func getURL(c *golangsdk.ServiceClient, serverID string) string {
 func jobURL(c *golangsdk.ServiceClient, jobID string) string {
 	return c.ServiceURL(jobsPath, jobID)
 }
+
+func actionURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath, "action")
+}
+
+func resizeURL(c *golangsdk.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "resize")
+}
+
+func changeOSURL(c *golangsdk.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "changeos")
+}
+
+func reinstallOSURL(c *golangsdk.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "reinstallos")
+}
+
+func nicsURL(c *golangsdk.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "nics")
+}
+
+func deleteNicsURL(c *golangsdk.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "nics", "delete")
+}
+
+func attachVolumeURL(c *golangsdk.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "attachvolume")
+}
+
+func detachVolumeURL(c *golangsdk.ServiceClient, serverID, volumeID string) string {
+	return c.ServiceURL(rootPath, serverID, "detachvolume", volumeID)
+}
+
+func listDetailURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath, "detail")
+}
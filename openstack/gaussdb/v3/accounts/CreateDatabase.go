@@ -0,0 +1,47 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type CreateDatabaseOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Databases to create
+	Databases []Database `json:"databases" required:"true"`
+}
+
+type Database struct {
+	// Database name. The name contains 1 to 64 characters, including letters, digits, hyphens (-), underscores (_), and dollar signs ($).
+	Name string `json:"name" required:"true"`
+	// Character set, for example, utf8mb4.
+	CharacterSet string `json:"character_set" required:"true"`
+	// Database remarks
+	Comment string `json:"comment,omitempty"`
+	// Accounts granted access to the database
+	Users []DatabaseUser `json:"users,omitempty"`
+}
+
+type DatabaseUser struct {
+	// Account name
+	Name string `json:"name" required:"true"`
+	// Host address from which the account can access the database. The default value is %.
+	Host string `json:"host,omitempty"`
+	// Whether the account has the read-only privilege
+	Readonly bool `json:"readonly,omitempty"`
+}
+
+// CreateDatabase creates the databases of the instance and returns the ID of the job.
+func CreateDatabase(client *golangsdk.ServiceClient, opts CreateDatabaseOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "databases"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return extractJob(err, raw)
+}
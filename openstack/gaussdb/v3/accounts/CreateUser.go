@@ -0,0 +1,48 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type CreateUserOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Accounts to create
+	Users []User `json:"users" required:"true"`
+}
+
+type User struct {
+	// Account name. The name contains 1 to 32 characters, including letters, digits, and underscores (_).
+	Name string `json:"name" required:"true"`
+	// Host address from which the account can access the instance. The default value is %.
+	Host string `json:"host,omitempty"`
+	// Account password. The password consists of 8 to 32 characters and contains at least three types of the following:
+	// uppercase letters, lowercase letters, digits, and special characters (~!@#$%^*-_=+?,()&).
+	Password string `json:"password" required:"true"`
+	// Account remarks
+	Comment string `json:"comment,omitempty"`
+	// Databases the account is granted access to
+	Databases []UserDatabase `json:"databases,omitempty"`
+}
+
+type UserDatabase struct {
+	// Database name
+	Name string `json:"name" required:"true"`
+	// Whether the account has the read-only privilege for the database
+	Readonly bool `json:"readonly"`
+}
+
+// CreateUser creates the database accounts of the instance and returns the ID of the job.
+func CreateUser(client *golangsdk.ServiceClient, opts CreateUserOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "db-users"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return extractJob(err, raw)
+}
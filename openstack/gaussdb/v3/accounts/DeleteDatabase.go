@@ -0,0 +1,27 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type DeleteDatabaseOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Names of the databases to delete
+	DatabaseNames []string `json:"database_names" required:"true"`
+}
+
+// DeleteDatabase deletes the databases of the instance and returns the ID of the job.
+func DeleteDatabase(client *golangsdk.ServiceClient, opts DeleteDatabaseOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases
+	raw, err := client.DeleteWithBody(client.ServiceURL("instances", opts.InstanceId, "databases"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
@@ -0,0 +1,34 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type DeleteUserOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Accounts to delete
+	Users []UserHost `json:"users" required:"true"`
+}
+
+type UserHost struct {
+	// Account name
+	Name string `json:"name" required:"true"`
+	// Host address of the account
+	Host string `json:"host" required:"true"`
+}
+
+// DeleteUser deletes the database accounts of the instance and returns the ID of the job.
+func DeleteUser(client *golangsdk.ServiceClient, opts DeleteUserOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users
+	raw, err := client.DeleteWithBody(client.ServiceURL("instances", opts.InstanceId, "db-users"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
@@ -0,0 +1,36 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type GrantPrivilegeOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Accounts and the databases they are granted access to
+	Users []UserPrivilege `json:"users" required:"true"`
+}
+
+type UserPrivilege struct {
+	// Account name
+	Name string `json:"name" required:"true"`
+	// Host address of the account
+	Host string `json:"host" required:"true"`
+	// Databases the account is granted access to
+	Databases []UserDatabase `json:"databases" required:"true"`
+}
+
+// GrantPrivilege grants the accounts access to the databases and returns the ID of the job.
+func GrantPrivilege(client *golangsdk.ServiceClient, opts GrantPrivilegeOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/privilege
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "db-users", "privilege"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return extractJob(err, raw)
+}
@@ -0,0 +1,51 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListDatabasesOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Index offset. The default value is 0.
+	Offset int `q:"offset"`
+	// Number of records to be queried. The default value is 100, the maximum value is 100.
+	Limit int `q:"limit"`
+}
+
+// ListDatabases returns the databases of the instance.
+func ListDatabases(client *golangsdk.ServiceClient, opts ListDatabasesOpts) (*ListDatabasesResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", opts.InstanceId, "databases").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/databases
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListDatabasesResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListDatabasesResponse struct {
+	// Databases of the instance
+	Databases []DatabaseInfo `json:"databases"`
+	// Total number of databases
+	TotalCount int `json:"total_count"`
+}
+
+type DatabaseInfo struct {
+	// Database name
+	Name string `json:"name"`
+	// Character set
+	Charset string `json:"charset"`
+	// Database remarks
+	Comment string `json:"comment"`
+	// Accounts granted access to the database
+	Users []DatabaseUser `json:"users"`
+}
@@ -0,0 +1,51 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ListUsersOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Index offset. The default value is 0.
+	Offset int `q:"offset"`
+	// Number of records to be queried. The default value is 100, the maximum value is 100.
+	Limit int `q:"limit"`
+}
+
+// ListUsers returns the database accounts of the instance.
+func ListUsers(client *golangsdk.ServiceClient, opts ListUsersOpts) (*ListUsersResponse, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", opts.InstanceId, "db-users").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListUsersResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListUsersResponse struct {
+	// Accounts of the instance
+	Users []UserInfo `json:"users"`
+	// Total number of accounts
+	TotalCount int `json:"total_count"`
+}
+
+type UserInfo struct {
+	// Account name
+	Name string `json:"name"`
+	// Host address from which the account can access the instance
+	Host string `json:"host"`
+	// Account remarks
+	Comment string `json:"comment"`
+	// Databases the account is granted access to
+	Databases []UserDatabase `json:"databases"`
+}
@@ -0,0 +1,36 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type ResetUserPasswordOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Accounts and their new passwords
+	Users []UserPassword `json:"users" required:"true"`
+}
+
+type UserPassword struct {
+	// Account name
+	Name string `json:"name" required:"true"`
+	// Host address of the account
+	Host string `json:"host" required:"true"`
+	// New password of the account
+	Password string `json:"password" required:"true"`
+}
+
+// ResetUserPassword changes the passwords of the database accounts and returns the ID of the job.
+func ResetUserPassword(client *golangsdk.ServiceClient, opts ResetUserPasswordOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/password
+	raw, err := client.Put(client.ServiceURL("instances", opts.InstanceId, "db-users", "password"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
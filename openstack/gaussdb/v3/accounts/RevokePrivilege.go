@@ -0,0 +1,36 @@
+package accounts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type RevokePrivilegeOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Accounts and the databases their access is revoked from
+	Users []RevokeUserPrivilege `json:"users" required:"true"`
+}
+
+type RevokeUserPrivilege struct {
+	// Account name
+	Name string `json:"name" required:"true"`
+	// Host address of the account
+	Host string `json:"host" required:"true"`
+	// Names of the databases
+	Databases []string `json:"databases" required:"true"`
+}
+
+// RevokePrivilege revokes the access of the accounts to the databases and returns the ID of the job.
+func RevokePrivilege(client *golangsdk.ServiceClient, opts RevokePrivilegeOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/db-users/privilege
+	raw, err := client.DeleteWithBody(client.ServiceURL("instances", opts.InstanceId, "db-users", "privilege"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
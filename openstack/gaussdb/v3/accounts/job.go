@@ -0,0 +1,19 @@
+package accounts
+
+import (
+	"net/http"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+func extractJob(err error, raw *http.Response) (*string, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		JobId string `json:"job_id"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return &res.JobId, err
+}
@@ -0,0 +1,2 @@
+// accounts unit tests
+package testing
@@ -0,0 +1,111 @@
+package testing
+
+const createDatabaseRequest = `
+{
+    "databases": [
+        {
+            "name": "app",
+            "character_set": "utf8mb4",
+            "comment": "application database",
+            "users": [
+                {
+                    "name": "app_user",
+                    "host": "%"
+                }
+            ]
+        }
+    ]
+}
+`
+
+const listDatabasesResponse = `
+{
+    "databases": [
+        {
+            "name": "app",
+            "charset": "utf8mb4",
+            "comment": "application database",
+            "users": [
+                {
+                    "name": "app_user",
+                    "host": "%",
+                    "readonly": false
+                }
+            ]
+        }
+    ],
+    "total_count": 1
+}
+`
+
+const createUserRequest = `
+{
+    "users": [
+        {
+            "name": "app_user",
+            "host": "%",
+            "password": "Secret-123",
+            "databases": [
+                {
+                    "name": "app",
+                    "readonly": false
+                }
+            ]
+        }
+    ]
+}
+`
+
+const listUsersResponse = `
+{
+    "users": [
+        {
+            "name": "app_user",
+            "host": "%",
+            "comment": "",
+            "databases": [
+                {
+                    "name": "app",
+                    "readonly": false
+                },
+                {
+                    "name": "reports",
+                    "readonly": true
+                }
+            ]
+        }
+    ],
+    "total_count": 1
+}
+`
+
+const grantRequest = `
+{
+    "users": [
+        {
+            "name": "app_user",
+            "host": "%",
+            "databases": [
+                {
+                    "name": "reports",
+                    "readonly": true
+                }
+            ]
+        }
+    ]
+}
+`
+
+const revokeRequest = `
+{
+    "users": [
+        {
+            "name": "app_user",
+            "host": "%",
+            "databases": [
+                "reports"
+            ]
+        }
+    ]
+}
+`
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/accounts"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestDatabases(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/databases", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, createDatabaseRequest)
+			_, _ = fmt.Fprint(w, `{"job_id": "create-job"}`)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"limit": "10"})
+			_, _ = fmt.Fprint(w, listDatabasesResponse)
+		case "DELETE":
+			th.TestJSONRequest(t, r, `{"database_names": ["app"]}`)
+			_, _ = fmt.Fprint(w, `{"job_id": "delete-job"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	jobId, err := accounts.CreateDatabase(fake.ServiceClient(), accounts.CreateDatabaseOpts{
+		InstanceId: "instance-id",
+		Databases: []accounts.Database{{
+			Name:         "app",
+			CharacterSet: "utf8mb4",
+			Comment:      "application database",
+			Users:        []accounts.DatabaseUser{{Name: "app_user", Host: "%"}},
+		}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "create-job", *jobId)
+
+	list, err := accounts.ListDatabases(fake.ServiceClient(), accounts.ListDatabasesOpts{
+		InstanceId: "instance-id",
+		Limit:      10,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+	th.AssertEquals(t, "utf8mb4", list.Databases[0].Charset)
+	th.AssertEquals(t, "app_user", list.Databases[0].Users[0].Name)
+
+	jobId, err = accounts.DeleteDatabase(fake.ServiceClient(), accounts.DeleteDatabaseOpts{
+		InstanceId:    "instance-id",
+		DatabaseNames: []string{"app"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "delete-job", *jobId)
+}
+
+func TestUsers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/db-users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, createUserRequest)
+			_, _ = fmt.Fprint(w, `{"job_id": "create-job"}`)
+		case "GET":
+			_, _ = fmt.Fprint(w, listUsersResponse)
+		case "DELETE":
+			th.TestJSONRequest(t, r, `{"users": [{"name": "app_user", "host": "%"}]}`)
+			_, _ = fmt.Fprint(w, `{"job_id": "delete-job"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	th.Mux.HandleFunc("/instances/instance-id/db-users/privilege", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, grantRequest)
+			_, _ = fmt.Fprint(w, `{"job_id": "grant-job"}`)
+		case "DELETE":
+			th.TestJSONRequest(t, r, revokeRequest)
+			_, _ = fmt.Fprint(w, `{"job_id": "revoke-job"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	th.Mux.HandleFunc("/instances/instance-id/db-users/password", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"users": [{"name": "app_user", "host": "%", "password": "Secret-456"}]}`)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"job_id": "password-job"}`)
+	})
+
+	jobId, err := accounts.CreateUser(fake.ServiceClient(), accounts.CreateUserOpts{
+		InstanceId: "instance-id",
+		Users: []accounts.User{{
+			Name:      "app_user",
+			Host:      "%",
+			Password:  "Secret-123",
+			Databases: []accounts.UserDatabase{{Name: "app"}},
+		}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "create-job", *jobId)
+
+	users, err := accounts.ListUsers(fake.ServiceClient(), accounts.ListUsersOpts{InstanceId: "instance-id"})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []accounts.UserDatabase{
+		{Name: "app"},
+		{Name: "reports", Readonly: true},
+	}, users.Users[0].Databases)
+
+	jobId, err = accounts.GrantPrivilege(fake.ServiceClient(), accounts.GrantPrivilegeOpts{
+		InstanceId: "instance-id",
+		Users: []accounts.UserPrivilege{{
+			Name:      "app_user",
+			Host:      "%",
+			Databases: []accounts.UserDatabase{{Name: "reports", Readonly: true}},
+		}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "grant-job", *jobId)
+
+	jobId, err = accounts.RevokePrivilege(fake.ServiceClient(), accounts.RevokePrivilegeOpts{
+		InstanceId: "instance-id",
+		Users:      []accounts.RevokeUserPrivilege{{Name: "app_user", Host: "%", Databases: []string{"reports"}}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "revoke-job", *jobId)
+
+	jobId, err = accounts.ResetUserPassword(fake.ServiceClient(), accounts.ResetUserPasswordOpts{
+		InstanceId: "instance-id",
+		Users:      []accounts.UserPassword{{Name: "app_user", Host: "%", Password: "Secret-456"}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "password-job", *jobId)
+
+	jobId, err = accounts.DeleteUser(fake.ServiceClient(), accounts.DeleteUserOpts{
+		InstanceId: "instance-id",
+		Users:      []accounts.UserHost{{Name: "app_user", Host: "%"}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "delete-job", *jobId)
+}
@@ -0,0 +1,41 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ApplyOpts struct {
+	// Parameter template ID
+	ConfigId string `json:"-"`
+	// IDs of the instances the parameter template is applied to
+	InstanceIds []string `json:"instance_ids" required:"true"`
+}
+
+// Apply applies the parameter template to the instances.
+func Apply(client *golangsdk.ServiceClient, opts ApplyOpts) (*ApplyResponse, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/configurations/{configuration_id}/apply
+	raw, err := client.Put(client.ServiceURL("configurations", opts.ConfigId, "apply"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ApplyResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ApplyResponse struct {
+	// ID of the job applying the parameter template
+	JobId string `json:"job_id"`
+	// Whether the parameter template is applied
+	Success bool `json:"success"`
+}
@@ -0,0 +1,43 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type CompareOpts struct {
+	// ID of the source parameter template
+	SourceConfigurationId string `json:"source_configuration_id" required:"true"`
+	// ID of the target parameter template
+	TargetConfigurationId string `json:"target_configuration_id" required:"true"`
+}
+
+// Compare returns the parameters with different values in the two parameter templates.
+func Compare(client *golangsdk.ServiceClient, opts CompareOpts) ([]Difference, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/configurations/comparison
+	raw, err := client.Post(client.ServiceURL("configurations", "comparison"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Difference
+	err = extract.IntoSlicePtr(raw.Body, &res, "differences")
+	return res, err
+}
+
+type Difference struct {
+	// Parameter name
+	ParameterName string `json:"parameter_name"`
+	// Parameter value in the source template
+	SourceValue string `json:"source_value"`
+	// Parameter value in the target template
+	TargetValue string `json:"target_value"`
+}
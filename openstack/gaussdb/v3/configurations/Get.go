@@ -0,0 +1,55 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// Get returns the parameter template with its parameters.
+func Get(client *golangsdk.ServiceClient, configId string) (*Configuration, error) {
+	// GET https://{Endpoint}/mysql/v3/{project_id}/configurations/{configuration_id}
+	raw, err := client.Get(client.ServiceURL("configurations", configId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Configuration
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type Configuration struct {
+	// Parameter template ID
+	Id string `json:"id"`
+	// Parameter template name
+	Name string `json:"name"`
+	// Parameter template description
+	Description string `json:"description"`
+	// DB version name
+	DatastoreVersionName string `json:"datastore_version_name"`
+	// Database name
+	DatastoreName string `json:"datastore_name"`
+	// Creation time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Created string `json:"created"`
+	// Update time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Updated string `json:"updated"`
+	// Parameters of the template
+	Parameters []Parameter `json:"configuration_parameters"`
+}
+
+type Parameter struct {
+	// Parameter name
+	Name string `json:"name"`
+	// Parameter value
+	Value string `json:"value"`
+	// Whether a reboot is required for the modification to take effect
+	RestartRequired bool `json:"restart_required"`
+	// Whether the parameter is read-only
+	ReadOnly bool `json:"readonly"`
+	// Value range, for example, the value of type integer ranges from 0 to 1, and the value of type boolean is true or false
+	ValueRange string `json:"value_range"`
+	// Parameter type. The value can be string, integer, boolean, list, or float.
+	Type string `json:"type"`
+	// Parameter description
+	Description string `json:"description"`
+}
@@ -0,0 +1,53 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type GetInstanceConfigurationOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Index offset. The default value is 0.
+	Offset int `q:"offset"`
+	// Number of records to be queried. The default value is 100, the maximum value is 100.
+	Limit int `q:"limit"`
+}
+
+// GetInstanceConfiguration returns the parameters of the instance.
+func GetInstanceConfiguration(client *golangsdk.ServiceClient, opts GetInstanceConfigurationOpts) (*InstanceConfiguration, error) {
+	url, err := golangsdk.NewURLBuilder().WithEndpoints("instances", opts.InstanceId, "configurations").WithQueryParams(&opts).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/configurations
+	raw, err := client.Get(client.ServiceURL(url.String()), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res InstanceConfiguration
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type InstanceConfiguration struct {
+	// Parameter template of the instance
+	Configurations ConfigurationInfo `json:"configurations"`
+	// Parameters of the instance
+	Parameters []Parameter `json:"parameter_values"`
+	// Total number of parameters
+	TotalCount int `json:"total_count"`
+}
+
+type ConfigurationInfo struct {
+	// DB version name
+	DatastoreVersionName string `json:"datastore_version_name"`
+	// Database name
+	DatastoreName string `json:"datastore_name"`
+	// Creation time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Created string `json:"created"`
+	// Update time in the "yyyy-MM-ddTHH:mm:ssZ" format.
+	Updated string `json:"updated"`
+}
@@ -0,0 +1,41 @@
+package configurations
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type UpdateInstanceConfigurationOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Parameter values to change, the keys are the parameter names.
+	ParameterValues map[string]string `json:"parameter_values" required:"true"`
+}
+
+// UpdateInstanceConfiguration changes the parameters of the instance.
+func UpdateInstanceConfiguration(client *golangsdk.ServiceClient, opts UpdateInstanceConfigurationOpts) (*UpdateInstanceConfigurationResponse, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/configurations
+	raw, err := client.Put(client.ServiceURL("instances", opts.InstanceId, "configurations"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res UpdateInstanceConfigurationResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type UpdateInstanceConfigurationResponse struct {
+	// ID of the job changing the parameters
+	JobId string `json:"job_id"`
+	// Whether a reboot is required for the changes to take effect
+	RestartRequired bool `json:"restart_required"`
+}
@@ -0,0 +1,59 @@
+package testing
+
+const getResponse = `
+{
+    "id": "config-id",
+    "name": "app-config",
+    "description": "application parameters",
+    "datastore_version_name": "8.0",
+    "datastore_name": "gaussdb-mysql",
+    "created": "2023-05-10T08:00:00+0000",
+    "updated": "2023-05-10T08:00:00+0000",
+    "configuration_parameters": [
+        {
+            "name": "max_connections",
+            "value": "1000",
+            "restart_required": false,
+            "readonly": false,
+            "value_range": "10-100000",
+            "type": "integer",
+            "description": "Maximum number of connections"
+        }
+    ]
+}
+`
+
+const compareResponse = `
+{
+    "differences": [
+        {
+            "parameter_name": "max_connections",
+            "source_value": "1000",
+            "target_value": "2000"
+        }
+    ]
+}
+`
+
+const instanceConfigurationResponse = `
+{
+    "configurations": {
+        "datastore_version_name": "8.0",
+        "datastore_name": "gaussdb-mysql",
+        "created": "2023-05-10T08:00:00+0000",
+        "updated": "2023-05-11T08:00:00+0000"
+    },
+    "parameter_values": [
+        {
+            "name": "max_connections",
+            "value": "2000",
+            "restart_required": false,
+            "readonly": false,
+            "value_range": "10-100000",
+            "type": "integer",
+            "description": "Maximum number of connections"
+        }
+    ],
+    "total_count": 1
+}
+`
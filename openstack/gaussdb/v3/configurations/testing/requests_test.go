@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestConfigurations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/configurations/config-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+	th.Mux.HandleFunc("/configurations/config-id/apply", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"instance_ids": ["instance-id"]}`)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"job_id": "apply-job", "success": true}`)
+	})
+	th.Mux.HandleFunc("/configurations/comparison", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"source_configuration_id": "config-id", "target_configuration_id": "other-id"}`)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, compareResponse)
+	})
+
+	config, err := configurations.Get(fake.ServiceClient(), "config-id")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "app-config", config.Name)
+	th.AssertDeepEquals(t, configurations.Parameter{
+		Name:        "max_connections",
+		Value:       "1000",
+		ValueRange:  "10-100000",
+		Type:        "integer",
+		Description: "Maximum number of connections",
+	}, config.Parameters[0])
+
+	applied, err := configurations.Apply(fake.ServiceClient(), configurations.ApplyOpts{
+		ConfigId:    "config-id",
+		InstanceIds: []string{"instance-id"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "apply-job", applied.JobId)
+	th.AssertEquals(t, true, applied.Success)
+
+	differences, err := configurations.Compare(fake.ServiceClient(), configurations.CompareOpts{
+		SourceConfigurationId: "config-id",
+		TargetConfigurationId: "other-id",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []configurations.Difference{
+		{ParameterName: "max_connections", SourceValue: "1000", TargetValue: "2000"},
+	}, differences)
+}
+
+func TestInstanceConfiguration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/configurations", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"limit": "10"})
+			_, _ = fmt.Fprint(w, instanceConfigurationResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"parameter_values": {"max_connections": "2000"}}`)
+			_, _ = fmt.Fprint(w, `{"job_id": "update-job", "restart_required": false}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	updated, err := configurations.UpdateInstanceConfiguration(fake.ServiceClient(), configurations.UpdateInstanceConfigurationOpts{
+		InstanceId:      "instance-id",
+		ParameterValues: map[string]string{"max_connections": "2000"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "update-job", updated.JobId)
+	th.AssertEquals(t, false, updated.RestartRequired)
+
+	current, err := configurations.GetInstanceConfiguration(fake.ServiceClient(), configurations.GetInstanceConfigurationOpts{
+		InstanceId: "instance-id",
+		Limit:      10,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, current.TotalCount)
+	th.AssertEquals(t, "2000", current.Parameters[0].Value)
+	th.AssertEquals(t, "gaussdb-mysql", current.Configurations.DatastoreName)
+}
@@ -0,0 +1,27 @@
+package proxy
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type DisableProxyOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// IDs of the proxies to disable. All proxies of the instance are disabled if not set.
+	ProxyIds []string `json:"proxy_ids,omitempty"`
+}
+
+// DisableProxy disables the database proxies of the instance and returns the ID of the job.
+func DisableProxy(client *golangsdk.ServiceClient, opts DisableProxyOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// DELETE https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy
+	raw, err := client.DeleteWithBody(client.ServiceURL("instances", opts.InstanceId, "proxy"), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
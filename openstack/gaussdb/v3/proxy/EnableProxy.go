@@ -0,0 +1,48 @@
+package proxy
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type EnableProxyOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Proxy specification code, see ListProxyFlavors.
+	FlavorRef string `json:"flavor_ref" required:"true"`
+	// Number of proxy nodes. The value ranges from 2 to 32.
+	NodeNum int `json:"node_num" required:"true"`
+	// Proxy name. The name must start with a letter and contain 4 to 64 characters.
+	ProxyName string `json:"proxy_name,omitempty"`
+	// Proxy mode. Value:
+	// readwrite (default): read and write.
+	// readonly: read-only.
+	ProxyMode string `json:"proxy_mode,omitempty"`
+	// Routing policy of the proxy. Value:
+	// 0: weighted round robin (default).
+	// 1: load balancing.
+	RouteMode *int `json:"route_mode,omitempty"`
+	// Read weights of the nodes. If not set, the read requests are distributed to the read replicas only.
+	NodesReadWeight []NodeWeight `json:"nodes_read_weight,omitempty"`
+}
+
+type NodeWeight struct {
+	// Node ID
+	Id string `json:"id" required:"true"`
+	// Read weight of the node. The value ranges from 0 to 1000.
+	Weight int `json:"weight"`
+}
+
+// EnableProxy enables a database proxy for the instance and returns the ID of the job.
+func EnableProxy(client *golangsdk.ServiceClient, opts EnableProxyOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "proxy"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 202},
+	})
+	return extractJob(err, raw)
+}
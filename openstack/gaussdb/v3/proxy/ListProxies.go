@@ -0,0 +1,87 @@
+package proxy
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// ListProxies returns the database proxies of the instance.
+func ListProxies(client *golangsdk.ServiceClient, instanceId string) ([]ProxyInfo, error) {
+	// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxies
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "proxies"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []ProxyInfo
+	err = extract.IntoSlicePtr(raw.Body, &res, "proxy_list")
+	return res, err
+}
+
+type ProxyInfo struct {
+	// Proxy information
+	Proxy Proxy `json:"proxy"`
+	// Primary node of the instance
+	MasterNode ProxyNode `json:"master_node"`
+	// Read replicas of the instance
+	ReadonlyNodes []ProxyNode `json:"readonly_nodes"`
+}
+
+type Proxy struct {
+	// Proxy ID
+	PoolId string `json:"pool_id"`
+	// Proxy name
+	Name string `json:"name"`
+	// Proxy status. Value:
+	// ACTIVE: The proxy is available.
+	// ABNORMAL: The proxy is abnormal.
+	// CREATING: The proxy is being created.
+	// DELETING: The proxy is being deleted.
+	Status string `json:"status"`
+	// Proxy read/write separation address
+	Address string `json:"address"`
+	// Proxy port
+	Port int `json:"port"`
+	// Number of proxy nodes
+	NodeNum int `json:"node_num"`
+	// Proxy specification code
+	FlavorRef string `json:"flavor_ref"`
+	// Number of vCPUs of the proxy nodes
+	Cpu string `json:"cpu"`
+	// Memory size of the proxy nodes in GB
+	Mem string `json:"mem"`
+	// Proxy mode, readwrite or readonly
+	Mode string `json:"mode"`
+	// Routing policy of the proxy. 0: weighted round robin, 1: load balancing.
+	RouteMode int `json:"route_mode"`
+	// Proxy nodes
+	Nodes []Node `json:"nodes"`
+}
+
+type Node struct {
+	// Proxy node ID
+	Id string `json:"id"`
+	// Proxy node name
+	Name string `json:"name"`
+	// Proxy node role, master or slave
+	Role string `json:"role"`
+	// AZ of the proxy node
+	AzCode string `json:"az_code"`
+	// Proxy node status, ACTIVE or ABNORMAL
+	Status string `json:"status"`
+	// Whether the proxy node is frozen. 0: unfrozen, 1: frozen, 2: deleted after being frozen.
+	FrozenFlag int `json:"frozen_flag"`
+}
+
+type ProxyNode struct {
+	// Node ID
+	Id string `json:"id"`
+	// Node name
+	Name string `json:"name"`
+	// Node status
+	Status string `json:"status"`
+	// Read weight of the node
+	Weight int `json:"weight"`
+	// AZ of the node
+	AzCode string `json:"az_code"`
+}
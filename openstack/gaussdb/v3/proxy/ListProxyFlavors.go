@@ -0,0 +1,41 @@
+package proxy
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+// ListProxyFlavors returns the proxy specifications available for the instance.
+func ListProxyFlavors(client *golangsdk.ServiceClient, instanceId string) ([]FlavorGroup, error) {
+	// GET https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy-flavors
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "proxy-flavors"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []FlavorGroup
+	err = extract.IntoSlicePtr(raw.Body, &res, "proxy_flavor_groups")
+	return res, err
+}
+
+type FlavorGroup struct {
+	// CPU architecture, x86 or ARM
+	GroupType string `json:"group_type"`
+	// Proxy specifications of the architecture
+	ProxyFlavors []Flavor `json:"proxy_flavors"`
+}
+
+type Flavor struct {
+	// Specification ID
+	Id string `json:"id"`
+	// Specification code, used as FlavorRef of EnableProxyOpts
+	SpecCode string `json:"spec_code"`
+	// Number of vCPUs
+	Vcpus string `json:"vcpus"`
+	// Memory size in GB
+	Ram string `json:"ram"`
+	// Database type
+	DbType string `json:"db_type"`
+	// Specification status in the AZs, normal or unsupported
+	AzStatus map[string]string `json:"az_status"`
+}
@@ -0,0 +1,31 @@
+package proxy
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+)
+
+type UpdateReadWeightOpts struct {
+	// Instance ID, which is compliant with the UUID format.
+	InstanceId string `json:"-"`
+	// Proxy ID
+	ProxyId string `json:"-"`
+	// Read weight of the primary node. The value ranges from 0 to 1000.
+	MasterWeight *int `json:"master_weight,omitempty"`
+	// Read weights of the read replicas.
+	ReadonlyNodes []NodeWeight `json:"readonly_nodes,omitempty"`
+}
+
+// UpdateReadWeight changes the read weights of the nodes of the instance and returns the ID of the job.
+func UpdateReadWeight(client *golangsdk.ServiceClient, opts UpdateReadWeightOpts) (*string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// PUT https://{Endpoint}/mysql/v3/{project_id}/instances/{instance_id}/proxy/{proxy_id}/weight
+	raw, err := client.Put(client.ServiceURL("instances", opts.InstanceId, "proxy", opts.ProxyId, "weight"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractJob(err, raw)
+}
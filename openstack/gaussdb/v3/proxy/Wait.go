@@ -0,0 +1,15 @@
+package proxy
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	v3 "github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3"
+)
+
+// WaitForProxy waits up to timeout seconds for the job returned by EnableProxy or UpdateReadWeight
+// to complete and returns the proxies of the instance.
+func WaitForProxy(client *golangsdk.ServiceClient, instanceId, jobId string, timeout int) ([]ProxyInfo, error) {
+	if _, err := v3.WaitForGaussJob(client, jobId, timeout); err != nil {
+		return nil, err
+	}
+	return ListProxies(client, instanceId)
+}
@@ -0,0 +1,2 @@
+// proxy unit tests
+package testing
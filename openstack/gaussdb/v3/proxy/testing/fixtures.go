@@ -0,0 +1,100 @@
+package testing
+
+const enableRequest = `
+{
+    "flavor_ref": "gaussdb.proxy.large.x86.2",
+    "node_num": 2,
+    "proxy_name": "app-proxy",
+    "route_mode": 0,
+    "nodes_read_weight": [
+        {
+            "id": "master-id",
+            "weight": 0
+        },
+        {
+            "id": "replica-id",
+            "weight": 100
+        }
+    ]
+}
+`
+
+const weightRequest = `
+{
+    "master_weight": 10,
+    "readonly_nodes": [
+        {
+            "id": "replica-id",
+            "weight": 90
+        }
+    ]
+}
+`
+
+const listResponse = `
+{
+    "proxy_list": [
+        {
+            "proxy": {
+                "pool_id": "proxy-id",
+                "name": "app-proxy",
+                "status": "ACTIVE",
+                "address": "192.168.0.20",
+                "port": 3306,
+                "node_num": 2,
+                "flavor_ref": "gaussdb.proxy.large.x86.2",
+                "mode": "readwrite",
+                "route_mode": 0,
+                "nodes": [
+                    {
+                        "id": "proxy-node-1",
+                        "role": "master",
+                        "status": "ACTIVE"
+                    },
+                    {
+                        "id": "proxy-node-2",
+                        "role": "slave",
+                        "status": "ACTIVE"
+                    }
+                ]
+            },
+            "master_node": {
+                "id": "master-id",
+                "name": "gauss-master",
+                "status": "ACTIVE",
+                "weight": 10
+            },
+            "readonly_nodes": [
+                {
+                    "id": "replica-id",
+                    "name": "gauss-replica",
+                    "status": "ACTIVE",
+                    "weight": 90
+                }
+            ]
+        }
+    ]
+}
+`
+
+const flavorsResponse = `
+{
+    "proxy_flavor_groups": [
+        {
+            "group_type": "X86",
+            "proxy_flavors": [
+                {
+                    "id": "flavor-id",
+                    "spec_code": "gaussdb.proxy.large.x86.2",
+                    "vcpus": "2",
+                    "ram": "4",
+                    "db_type": "PROXY",
+                    "az_status": {
+                        "eu-de-01": "normal"
+                    }
+                }
+            ]
+        }
+    ]
+}
+`
@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/proxy"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func handleJobs(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"job": {"id": "%s", "status": "Completed"}}`, r.URL.Query().Get("id"))
+	})
+}
+
+func TestProxy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleJobs(t)
+
+	th.Mux.HandleFunc("/instances/instance-id/proxy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, enableRequest)
+			_, _ = fmt.Fprint(w, `{"job_id": "enable-job"}`)
+		case "DELETE":
+			th.TestJSONRequest(t, r, `{"proxy_ids": ["proxy-id"]}`)
+			_, _ = fmt.Fprint(w, `{"job_id": "disable-job"}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+	th.Mux.HandleFunc("/instances/instance-id/proxies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/proxy/proxy-id/weight", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, weightRequest)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"job_id": "weight-job"}`)
+	})
+
+	jobId, err := proxy.EnableProxy(fake.ServiceClient(), proxy.EnableProxyOpts{
+		InstanceId: "instance-id",
+		FlavorRef:  "gaussdb.proxy.large.x86.2",
+		NodeNum:    2,
+		ProxyName:  "app-proxy",
+		RouteMode:  pointerto.Int(0),
+		NodesReadWeight: []proxy.NodeWeight{
+			{Id: "master-id", Weight: 0},
+			{Id: "replica-id", Weight: 100},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "enable-job", *jobId)
+
+	proxies, err := proxy.WaitForProxy(fake.ServiceClient(), "instance-id", *jobId, 10)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(proxies))
+	th.AssertEquals(t, "192.168.0.20", proxies[0].Proxy.Address)
+	th.AssertEquals(t, 2, len(proxies[0].Proxy.Nodes))
+	th.AssertEquals(t, 90, proxies[0].ReadonlyNodes[0].Weight)
+
+	jobId, err = proxy.UpdateReadWeight(fake.ServiceClient(), proxy.UpdateReadWeightOpts{
+		InstanceId:    "instance-id",
+		ProxyId:       proxies[0].Proxy.PoolId,
+		MasterWeight:  pointerto.Int(10),
+		ReadonlyNodes: []proxy.NodeWeight{{Id: "replica-id", Weight: 90}},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "weight-job", *jobId)
+
+	jobId, err = proxy.DisableProxy(fake.ServiceClient(), proxy.DisableProxyOpts{
+		InstanceId: "instance-id",
+		ProxyIds:   []string{"proxy-id"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "disable-job", *jobId)
+}
+
+func TestListProxyFlavors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/proxy-flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, flavorsResponse)
+	})
+
+	groups, err := proxy.ListProxyFlavors(fake.ServiceClient(), "instance-id")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "X86", groups[0].GroupType)
+	th.AssertEquals(t, "gaussdb.proxy.large.x86.2", groups[0].ProxyFlavors[0].SpecCode)
+	th.AssertEquals(t, "normal", groups[0].ProxyFlavors[0].AzStatus["eu-de-01"])
+}
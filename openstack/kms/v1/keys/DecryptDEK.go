@@ -0,0 +1,43 @@
+package keys
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type DecryptDEKOpts struct {
+	// ID of a CMK
+	KeyID string `json:"key_id" required:"true"`
+	// Hex ciphertext of the DEK and its SHA-256 hash, as returned by DataEncryptGet
+	CipherText string `json:"cipher_text" required:"true"`
+	// Number of bytes of the DEK
+	DatakeyCipherLength string `json:"datakey_cipher_length" required:"true"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+func DecryptDEK(client *golangsdk.ServiceClient, opts DecryptDEKOpts) (*DecryptDEKResp, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Post(client.ServiceURL("kms", "decrypt-datakey"), b, nil,
+		&golangsdk.RequestOpts{OkCodes: []int{200}})
+	if err != nil {
+		return nil, err
+	}
+	var res DecryptDEKResp
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type DecryptDEKResp struct {
+	// Hex plaintext of the DEK
+	DataKey string `json:"data_key"`
+	// Number of bytes of the DEK
+	DataKeyLength string `json:"datakey_length"`
+	// Hex SHA-256 hash of the DEK plaintext
+	DataKeyDigest string `json:"datakey_dgst"`
+}
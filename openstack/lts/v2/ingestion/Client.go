@@ -0,0 +1,357 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/streams"
+)
+
+var (
+	// ErrBufferFull is returned by Write when the buffer of the client is full. The event is dropped.
+	ErrBufferFull = errors.New("LTS ingestion buffer is full")
+	// ErrClosed is returned by Write after the client is closed. The event is dropped.
+	ErrClosed = errors.New("LTS ingestion client is closed")
+)
+
+type ClientOpts struct {
+	// ID of the log group
+	GroupId string
+	// ID of the log stream
+	StreamId string
+	// Labels of all log events reported by the client
+	Labels map[string]string
+	// Maximum number of events per request, defaults to 500.
+	BatchSize int
+	// Maximum size of the events per request in bytes, defaults to 512 KiB.
+	// An event larger than BatchBytes is sent alone.
+	BatchBytes int
+	// Interval of sending the buffered events, defaults to 1 second.
+	FlushInterval time.Duration
+	// Maximum number of buffered events, defaults to 10000. Events written to
+	// a full buffer are dropped.
+	BufferSize int
+	// Number of retries of a failed request, defaults to 3. Negative values disable retries.
+	// Requests rejected with 4xx response codes, except 408 and 429, are not retried.
+	MaxRetries int
+	// Delay before the first retry, doubled for every next retry. Defaults to 100 milliseconds.
+	MinBackoff time.Duration
+	// Maximum delay between the retries, defaults to 10 seconds.
+	MaxBackoff time.Duration
+	// OnError is called from the sending goroutine with the error and the number of events
+	// of a batch failed to be sent after all retries.
+	OnError func(err error, events int)
+}
+
+// Stats contains the counters of a client.
+type Stats struct {
+	// Events accepted by Write
+	Written uint64
+	// Events sent to LTS
+	Sent uint64
+	// Events dropped because the buffer was full or the client was closed
+	Dropped uint64
+	// Events failed to be sent after all retries
+	Failed uint64
+	// Events waiting in the buffer
+	Buffered int
+}
+
+type event struct {
+	time    time.Time
+	content string
+}
+
+// Client reports log events to an LTS log stream. Written events are buffered and sent in batches
+// by a background goroutine, when a batch is full or every FlushInterval. The client implements
+// io.Writer, so it can be used as an output of the log package. It is safe for concurrent use.
+type Client struct {
+	client *golangsdk.ServiceClient
+	opts   ClientOpts
+
+	mu     sync.Mutex
+	buffer []event
+	bytes  int
+	closed bool
+	stats  Stats
+
+	wake  chan struct{}
+	flush chan chan error
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// NewClient creates a client for the log stream and starts its sending goroutine.
+// The client must be closed with Close to send the buffered events.
+func NewClient(client *golangsdk.ServiceClient, opts ClientOpts) (*Client, error) {
+	if opts.GroupId == "" || opts.StreamId == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "GroupId/StreamId"}
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.BatchBytes <= 0 {
+		opts.BatchBytes = 512 * 1024
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 10000
+	}
+	switch {
+	case opts.MaxRetries == 0:
+		opts.MaxRetries = 3
+	case opts.MaxRetries < 0:
+		opts.MaxRetries = 0
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = 100 * time.Millisecond
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Second
+	}
+
+	c := &Client{
+		client: client,
+		opts:   opts,
+		wake:   make(chan struct{}, 1),
+		flush:  make(chan chan error),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go c.run()
+	return c, nil
+}
+
+// Write adds a log event with the content of p, without the trailing newlines, to the buffer.
+// Empty events are ignored.
+func (c *Client) Write(p []byte) (int, error) {
+	content := strings.TrimRight(string(p), "\r\n")
+	if content == "" {
+		return len(p), nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		c.stats.Dropped++
+		return 0, ErrClosed
+	}
+	if len(c.buffer) >= c.opts.BufferSize {
+		c.stats.Dropped++
+		return 0, ErrBufferFull
+	}
+	c.buffer = append(c.buffer, event{time: time.Now(), content: content})
+	c.bytes += len(content)
+	c.stats.Written++
+
+	if c.batchReady() {
+		select {
+		case c.wake <- struct{}{}:
+		default:
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends all buffered events. It returns the last error of the failed batches.
+func (c *Client) Flush(ctx context.Context) error {
+	res := make(chan error, 1)
+	select {
+	case c.flush <- res:
+	case <-c.done:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-res:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close sends the buffered events and stops the client. The events written after Close are
+// dropped. If ctx expires first, the retries are abandoned and the remaining events are dropped.
+func (c *Client) Close(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.mu.Unlock()
+
+	err := c.Flush(ctx)
+	close(c.stop)
+
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		if err == nil {
+			err = ctx.Err()
+		}
+	}
+	return err
+}
+
+// Stats returns the current counters of the client.
+func (c *Client) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Buffered = len(c.buffer)
+	return stats
+}
+
+func (c *Client) run() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.opts.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.wake:
+			c.send(false)
+		case <-ticker.C:
+			c.send(true)
+		case res := <-c.flush:
+			res <- c.send(true)
+		case <-c.stop:
+			c.drop()
+			return
+		}
+	}
+}
+
+// drop discards the buffered events.
+func (c *Client) drop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.Dropped += uint64(len(c.buffer))
+	c.buffer, c.bytes = nil, 0
+}
+
+// batchReady returns true if the buffer holds a full batch. c.mu must be held.
+func (c *Client) batchReady() bool {
+	return len(c.buffer) >= c.opts.BatchSize || c.bytes >= c.opts.BatchBytes
+}
+
+// send sends the buffered events, all of them or the full batches only. After the client is
+// stopped, the events not sent yet are dropped.
+func (c *Client) send(all bool) error {
+	var lastErr error
+	for {
+		select {
+		case <-c.stop:
+			c.drop()
+			return lastErr
+		default:
+		}
+
+		batch := c.takeBatch(all)
+		if len(batch) == 0 {
+			return lastErr
+		}
+
+		err := c.deliver(batch)
+
+		c.mu.Lock()
+		if err != nil {
+			c.stats.Failed += uint64(len(batch))
+		} else {
+			c.stats.Sent += uint64(len(batch))
+		}
+		c.mu.Unlock()
+
+		if err != nil {
+			lastErr = err
+			if c.opts.OnError != nil {
+				c.opts.OnError(err, len(batch))
+			}
+		}
+	}
+}
+
+// takeBatch removes the next batch from the buffer.
+func (c *Client) takeBatch(all bool) []event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.buffer) == 0 || !all && !c.batchReady() {
+		return nil
+	}
+
+	n, size := 0, 0
+	for n < len(c.buffer) && n < c.opts.BatchSize {
+		if n > 0 && size+len(c.buffer[n].content) > c.opts.BatchBytes {
+			break
+		}
+		size += len(c.buffer[n].content)
+		n++
+	}
+
+	batch := make([]event, n)
+	copy(batch, c.buffer)
+	c.buffer = append(c.buffer[:0], c.buffer[n:]...)
+	c.bytes -= size
+	return batch
+}
+
+// deliver sends the batch, retrying the failed requests. The time of the batch is the time of its first event.
+func (c *Client) deliver(batch []event) error {
+	contents := make([]string, len(batch))
+	for i, e := range batch {
+		contents[i] = e.content
+	}
+	opts := streams.ReportLogsOpts{
+		GroupId:   c.opts.GroupId,
+		StreamId:  c.opts.StreamId,
+		LogTimeNs: batch[0].time.UnixNano(),
+		Contents:  contents,
+		Labels:    c.opts.Labels,
+	}
+
+	backoff := c.opts.MinBackoff
+	for attempt := 0; ; attempt++ {
+		_, err := streams.ReportLogs(c.client, opts)
+		if err == nil || attempt >= c.opts.MaxRetries || !retryable(err) {
+			return err
+		}
+
+		delay := backoff + time.Duration(rand.Int63n(int64(backoff)/2+1))
+		select {
+		case <-time.After(delay):
+		case <-c.stop:
+			return err
+		}
+		if backoff *= 2; backoff > c.opts.MaxBackoff {
+			backoff = c.opts.MaxBackoff
+		}
+	}
+}
+
+// retryable returns false for the errors of requests rejected by LTS.
+func retryable(err error) bool {
+	switch e := err.(type) {
+	case golangsdk.ErrDefault400, golangsdk.ErrDefault401, golangsdk.ErrDefault403,
+		golangsdk.ErrDefault404, golangsdk.ErrDefault405, golangsdk.ErrDefault409,
+		golangsdk.ErrMissingInput, golangsdk.ErrInvalidInput:
+		return false
+	case golangsdk.ErrUnexpectedResponseCode:
+		return e.Actual >= 500 || e.Actual == 408 || e.Actual == 429
+	}
+	return true
+}
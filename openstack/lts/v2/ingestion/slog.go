@@ -0,0 +1,13 @@
+//go:build go1.21
+
+package ingestion
+
+import (
+	"log/slog"
+)
+
+// NewHandler returns a slog.Handler reporting the records as JSON log events to the client.
+// The records are formatted like by slog.JSONHandler, one event per record.
+func NewHandler(client *Client, opts *slog.HandlerOptions) slog.Handler {
+	return slog.NewJSONHandler(client, opts)
+}
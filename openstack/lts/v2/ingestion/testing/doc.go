@@ -0,0 +1,2 @@
+// ingestion unit tests
+package testing
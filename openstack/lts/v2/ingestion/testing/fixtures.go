@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const reportResponse = `
+{
+    "errorCode": "SVCSTG.ALS.200.200",
+    "errorMessage": "Report success.",
+    "result": null
+}
+`
+
+type reportRequest struct {
+	LogTimeNs int64             `json:"log_time_ns"`
+	Contents  []string          `json:"contents"`
+	Labels    map[string]string `json:"labels"`
+}
+
+// reportServer records the report requests. The first len(statuses) requests are answered
+// with the given status codes, the next ones succeed. Every response is delayed by delay.
+type reportServer struct {
+	mu       sync.Mutex
+	statuses []int
+	delay    time.Duration
+	requests []reportRequest
+}
+
+func handleReport(t *testing.T, statuses ...int) *reportServer {
+	server := &reportServer{statuses: statuses}
+	th.Mux.HandleFunc("/lts/groups/group-id/streams/stream-id/tenant/contents", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		var req reportRequest
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&req))
+
+		server.mu.Lock()
+		server.requests = append(server.requests, req)
+		status := http.StatusOK
+		if len(server.statuses) > 0 {
+			status, server.statuses = server.statuses[0], server.statuses[1:]
+		}
+		delay := server.delay
+		server.mu.Unlock()
+
+		time.Sleep(delay)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(reportResponse))
+		}
+	})
+	return server
+}
+
+func (s *reportServer) Requests() []reportRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]reportRequest(nil), s.requests...)
+}
+
+func (s *reportServer) Contents() []string {
+	var contents []string
+	for _, req := range s.Requests() {
+		contents = append(contents, req.Contents...)
+	}
+	return contents
+}
@@ -0,0 +1,216 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/ingestion"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestBatching(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t)
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		Labels:        map[string]string{"service": "api"},
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+	th.AssertNoErr(t, err)
+
+	var expected []string
+	for i := 0; i < 5; i++ {
+		line := fmt.Sprintf("event %d", i)
+		expected = append(expected, line)
+		_, err := fmt.Fprintln(client, line)
+		th.AssertNoErr(t, err)
+	}
+	th.AssertNoErr(t, client.Flush(context.Background()))
+
+	th.AssertDeepEquals(t, expected, server.Contents())
+	for _, req := range server.Requests() {
+		th.AssertEquals(t, true, len(req.Contents) <= 2)
+		th.AssertEquals(t, "api", req.Labels["service"])
+		th.AssertEquals(t, true, req.LogTimeNs > 0)
+	}
+
+	th.AssertNoErr(t, client.Close(context.Background()))
+	th.AssertDeepEquals(t, ingestion.Stats{Written: 5, Sent: 5}, client.Stats())
+}
+
+func TestBatchBytes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t)
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		BatchBytes:    10,
+		FlushInterval: time.Hour,
+	})
+	th.AssertNoErr(t, err)
+
+	for _, line := range []string{"123456", "1234", "12", "123456789012"} {
+		_, err := client.Write([]byte(line))
+		th.AssertNoErr(t, err)
+	}
+	th.AssertNoErr(t, client.Close(context.Background()))
+
+	var batches [][]string
+	for _, req := range server.Requests() {
+		batches = append(batches, req.Contents)
+	}
+	th.AssertDeepEquals(t, [][]string{{"123456", "1234"}, {"12"}, {"123456789012"}}, batches)
+}
+
+func TestFlushInterval(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t)
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		FlushInterval: 10 * time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+	defer client.Close(context.Background())
+
+	_, err = client.Write([]byte("event\n"))
+	th.AssertNoErr(t, err)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for client.Stats().Sent == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	th.AssertDeepEquals(t, []string{"event"}, server.Contents())
+}
+
+func TestRetry(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t, http.StatusInternalServerError, http.StatusServiceUnavailable)
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		FlushInterval: time.Hour,
+		MinBackoff:    time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+
+	_, err = client.Write([]byte("event"))
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, client.Close(context.Background()))
+
+	th.AssertEquals(t, 3, len(server.Requests()))
+	th.AssertEquals(t, uint64(1), client.Stats().Sent)
+}
+
+func TestFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t, http.StatusBadRequest)
+
+	var failed int
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		FlushInterval: time.Hour,
+		MinBackoff:    time.Millisecond,
+		OnError: func(err error, events int) {
+			failed += events
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	_, err = client.Write([]byte("first\nsecond"))
+	th.AssertNoErr(t, err)
+	_, err = client.Write([]byte("third"))
+	th.AssertNoErr(t, err)
+
+	err = client.Close(context.Background())
+	if _, ok := err.(golangsdk.ErrDefault400); !ok {
+		t.Fatalf("expected ErrDefault400, got %v", err)
+	}
+	th.AssertEquals(t, 1, len(server.Requests()))
+	th.AssertEquals(t, 2, failed)
+	th.AssertDeepEquals(t, ingestion.Stats{Written: 2, Failed: 2}, client.Stats())
+}
+
+func TestBufferFull(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t)
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		FlushInterval: time.Hour,
+		BufferSize:    2,
+	})
+	th.AssertNoErr(t, err)
+
+	for _, line := range []string{"first", "second"} {
+		_, err := client.Write([]byte(line))
+		th.AssertNoErr(t, err)
+	}
+	_, err = client.Write([]byte("third"))
+	th.AssertEquals(t, ingestion.ErrBufferFull, err)
+	th.AssertDeepEquals(t, ingestion.Stats{Written: 2, Dropped: 1, Buffered: 2}, client.Stats())
+
+	th.AssertNoErr(t, client.Close(context.Background()))
+	_, err = client.Write([]byte("fourth"))
+	th.AssertEquals(t, ingestion.ErrClosed, err)
+
+	th.AssertDeepEquals(t, []string{"first", "second"}, server.Contents())
+	th.AssertDeepEquals(t, ingestion.Stats{Written: 2, Sent: 2, Dropped: 2}, client.Stats())
+}
+
+func TestCloseCancelled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t)
+	server.delay = 200 * time.Millisecond
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		BatchSize:     1,
+		FlushInterval: time.Hour,
+	})
+	th.AssertNoErr(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err := fmt.Fprintf(client, "event %d", i)
+		th.AssertNoErr(t, err)
+	}
+	for len(server.Requests()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	th.AssertEquals(t, context.Canceled, client.Close(ctx))
+	th.AssertEquals(t, true, time.Since(start) < server.delay)
+
+	// the request in progress completes, the remaining events are dropped
+	deadline := time.Now().Add(5 * time.Second)
+	for client.Stats().Sent == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(2 * server.delay)
+	th.AssertDeepEquals(t, ingestion.Stats{Written: 5, Sent: 1, Dropped: 4}, client.Stats())
+	th.AssertDeepEquals(t, []string{"event 0"}, server.Contents())
+}
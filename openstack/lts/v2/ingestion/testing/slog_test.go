@@ -0,0 +1,43 @@
+//go:build go1.21
+
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/ingestion"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestHandler(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := handleReport(t)
+
+	client, err := ingestion.NewClient(fake.ServiceClient(), ingestion.ClientOpts{
+		GroupId:       "group-id",
+		StreamId:      "stream-id",
+		FlushInterval: time.Hour,
+	})
+	th.AssertNoErr(t, err)
+
+	logger := slog.New(ingestion.NewHandler(client, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	logger.Debug("skipped")
+	logger.With("service", "api").Info("request handled", "status", 200)
+	th.AssertNoErr(t, client.Close(context.Background()))
+
+	contents := server.Contents()
+	th.AssertEquals(t, 1, len(contents))
+
+	var record map[string]interface{}
+	th.AssertNoErr(t, json.Unmarshal([]byte(contents[0]), &record))
+	th.AssertEquals(t, "INFO", record["level"])
+	th.AssertEquals(t, "request handled", record["msg"])
+	th.AssertEquals(t, "api", record["service"])
+	th.AssertEquals(t, float64(200), record["status"])
+}
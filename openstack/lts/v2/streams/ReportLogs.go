@@ -0,0 +1,48 @@
+package streams
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+)
+
+type ReportLogsOpts struct {
+	GroupId  string `json:"-" required:"true"`
+	StreamId string `json:"-" required:"true"`
+	// Time of the logs in nanoseconds since the Unix epoch.
+	LogTimeNs int64 `json:"log_time_ns" required:"true"`
+	// Log events, one line per event.
+	// Maximum length of an event: 10,000 characters
+	Contents []string `json:"contents" required:"true"`
+	// Labels of the log events.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ReportLogs writes log events to the log stream.
+func ReportLogs(client *golangsdk.ServiceClient, opts ReportLogsOpts) (*ReportLogsResponse, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST /v2/{project_id}/lts/groups/{log_group_id}/streams/{log_stream_id}/tenant/contents
+	raw, err := client.Post(client.ServiceURL("lts", "groups", opts.GroupId, "streams", opts.StreamId, "tenant", "contents"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ReportLogsResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ReportLogsResponse struct {
+	// Error code. Example: SVCSTG.ALS.200.200
+	ErrorCode string `json:"errorCode"`
+	// Error message.
+	ErrorMessage string `json:"errorMessage"`
+	// Response result.
+	Result string `json:"result"`
+}
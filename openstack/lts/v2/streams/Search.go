@@ -0,0 +1,196 @@
+package streams
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// MaxSearchWindow is the longest time range of a single ListLogs query.
+const MaxSearchWindow = 30 * 24 * time.Hour
+
+type SearchOpts struct {
+	GroupId  string
+	StreamId string
+	// Start of the searched time range, defaults to MaxSearchWindow before EndTime.
+	StartTime time.Time
+	// End of the searched time range, defaults to the current time.
+	EndTime time.Time
+	// Filter criteria, see ListLogsOpts.
+	Labels map[string]string
+	// Keyword used for search, see ListLogsOpts.
+	Keywords string
+	// Whether the logs are returned newest first.
+	IsDesc bool
+	// Time range of a single query, defaults to 24 hours, at most MaxSearchWindow.
+	Window time.Duration
+	// Number of logs queried per request, defaults to 100.
+	Limit int32
+}
+
+// SearchIterator iterates over the logs of a time range. The range is split into windows queried
+// one after another, the logs of a window are read page by page. It is used like bufio.Scanner:
+//
+//	it := streams.Search(client, opts)
+//	for it.Next() {
+//		log := it.Log()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type SearchIterator struct {
+	client *golangsdk.ServiceClient
+	opts   SearchOpts
+
+	// current window
+	start, end time.Time
+	started    bool
+	windowDone bool
+	cursor     string
+
+	page []LogContents
+	pos  int
+	// line numbers of the previous page, for removing the cursor line repeated by the next page
+	previous map[string]struct{}
+
+	log  LogContents
+	err  error
+	done bool
+}
+
+// Search returns an iterator over the logs matching the options. No request is sent before the first
+// call of Next. A StartTime after the EndTime is reported by Err.
+func Search(client *golangsdk.ServiceClient, opts SearchOpts) *SearchIterator {
+	if opts.EndTime.IsZero() {
+		opts.EndTime = time.Now()
+	}
+	if opts.StartTime.IsZero() {
+		opts.StartTime = opts.EndTime.Add(-MaxSearchWindow)
+	}
+	if opts.StartTime.After(opts.EndTime) {
+		return &SearchIterator{err: fmt.Errorf("start time %s is after end time %s",
+			opts.StartTime.Format(time.RFC3339), opts.EndTime.Format(time.RFC3339))}
+	}
+	if opts.Window <= 0 {
+		opts.Window = 24 * time.Hour
+	}
+	if opts.Window > MaxSearchWindow {
+		opts.Window = MaxSearchWindow
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &SearchIterator{client: client, opts: opts}
+}
+
+// Next advances the iterator to the next log, which is then available through Log.
+// It returns false when there are no more logs or an error occurs.
+func (it *SearchIterator) Next() bool {
+	for !it.done && it.err == nil {
+		if it.pos < len(it.page) {
+			it.log = it.page[it.pos]
+			it.pos++
+			return true
+		}
+		it.fetch()
+	}
+	return false
+}
+
+// Log returns the current log.
+func (it *SearchIterator) Log() LogContents {
+	return it.log
+}
+
+// Err returns the first error of the iteration.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// fetch reads the next page of the current window, moving to the next window if the current one is exhausted.
+func (it *SearchIterator) fetch() {
+	if !it.started || it.windowDone {
+		if !it.nextWindow() {
+			it.done = true
+			return
+		}
+	}
+
+	opts := ListLogsOpts{
+		GroupId:   it.opts.GroupId,
+		StreamId:  it.opts.StreamId,
+		StartTime: strconv.FormatInt(it.start.UnixMilli(), 10),
+		EndTime:   strconv.FormatInt(it.end.UnixMilli(), 10),
+		Labels:    it.opts.Labels,
+		Keywords:  it.opts.Keywords,
+		IsDesc:    &it.opts.IsDesc,
+		Limit:     it.opts.Limit,
+	}
+	if it.cursor != "" {
+		opts.LineNum = it.cursor
+		opts.SearchType = "forwards"
+		if it.opts.IsDesc {
+			opts.SearchType = "backwards"
+		}
+	}
+
+	cursor := it.cursor
+	res, err := ListLogs(it.client, opts)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page, it.pos = it.page[:0], 0
+	lines := make(map[string]struct{}, len(res.Logs))
+	for _, log := range res.Logs {
+		lines[log.LineNum] = struct{}{}
+		if _, ok := it.previous[log.LineNum]; !ok {
+			it.page = append(it.page, log)
+		}
+	}
+	if len(res.Logs) > 0 {
+		it.previous = lines
+		it.cursor = res.Logs[len(res.Logs)-1].LineNum
+	}
+	// a window is exhausted by a short page or a page not moving the cursor
+	it.windowDone = len(res.Logs) < int(it.opts.Limit) || it.cursor == cursor
+}
+
+// nextWindow moves to the next time window, returning false after the last one. The windows don't
+// overlap, the time ranges of the queries are inclusive and in milliseconds.
+func (it *SearchIterator) nextWindow() bool {
+	first := !it.started
+	it.started = true
+	it.windowDone = false
+	it.cursor = ""
+
+	if it.opts.IsDesc {
+		if first {
+			it.end = it.opts.EndTime
+		} else if !it.start.After(it.opts.StartTime) {
+			return false
+		} else {
+			it.end = it.start.Add(-time.Millisecond)
+		}
+		it.start = it.end.Add(-it.opts.Window)
+		if it.start.Before(it.opts.StartTime) {
+			it.start = it.opts.StartTime
+		}
+		return true
+	}
+
+	if first {
+		it.start = it.opts.StartTime
+	} else if !it.end.Before(it.opts.EndTime) {
+		return false
+	} else {
+		it.start = it.end.Add(time.Millisecond)
+	}
+	it.end = it.start.Add(it.opts.Window)
+	if it.end.After(it.opts.EndTime) {
+		it.end = it.opts.EndTime
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package streams
+
+import (
+	"context"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type TailOpts struct {
+	GroupId  string
+	StreamId string
+	// Keyword used for search, see ListLogsOpts.
+	Keywords string
+	// Filter criteria, see ListLogsOpts.
+	Labels map[string]string
+	// Interval between the queries for new logs, defaults to 2 seconds.
+	PollInterval time.Duration
+	// Since returns the logs of the period before the start of the tail first.
+	Since time.Duration
+	// Overlap of the queried time ranges, catching the logs reported with a delay. Defaults to 10 seconds.
+	Overlap time.Duration
+}
+
+// Tail streams the new logs of the log stream matching the keywords, see TailWithOpts.
+func Tail(ctx context.Context, client *golangsdk.ServiceClient, groupId, streamId, keywords string) (<-chan LogContents, <-chan error) {
+	return TailWithOpts(ctx, client, TailOpts{GroupId: groupId, StreamId: streamId, Keywords: keywords})
+}
+
+// TailWithOpts polls the log stream for new logs and sends them to the returned logs channel in the
+// order of their arrival. Both channels are closed when ctx is done or a query fails; the error of the
+// failed query is sent to the errors channel first.
+func TailWithOpts(ctx context.Context, client *golangsdk.ServiceClient, opts TailOpts) (<-chan LogContents, <-chan error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+	if opts.Overlap <= 0 {
+		opts.Overlap = 10 * time.Second
+	}
+
+	logs := make(chan LogContents, 100)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(logs)
+
+		from := time.Now().Add(-opts.Since)
+		// time of the query returning the line, by line number
+		seen := make(map[string]time.Time)
+		for {
+			to := time.Now()
+			it := Search(client, SearchOpts{
+				GroupId:   opts.GroupId,
+				StreamId:  opts.StreamId,
+				StartTime: from,
+				EndTime:   to,
+				Labels:    opts.Labels,
+				Keywords:  opts.Keywords,
+				Window:    MaxSearchWindow,
+			})
+			for it.Next() {
+				log := it.Log()
+				if _, ok := seen[log.LineNum]; ok {
+					continue
+				}
+				seen[log.LineNum] = to
+
+				select {
+				case logs <- log:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err := it.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			// lines returned before the start of the next range can't be returned again
+			from = to.Add(-opts.Overlap)
+			for line, queried := range seen {
+				if queried.Before(from) {
+					delete(seen, line)
+				}
+			}
+
+			select {
+			case <-time.After(opts.PollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return logs, errs
+}
@@ -0,0 +1,2 @@
+// streams unit tests
+package testing
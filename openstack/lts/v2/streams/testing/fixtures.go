@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+type storedLog struct {
+	time    int64
+	lineNum string
+	content string
+}
+
+type listLogsRequest struct {
+	StartTime  string `json:"start_time"`
+	EndTime    string `json:"end_time"`
+	Keywords   string `json:"keywords"`
+	LineNum    string `json:"line_num"`
+	IsDesc     bool   `json:"is_desc"`
+	SearchType string `json:"search_type"`
+	Limit      int    `json:"limit"`
+}
+
+// logServer emulates the log query of a stream. Paginated queries return the logs starting with
+// the line_num cursor, so that the boundary lines are repeated.
+type logServer struct {
+	mu       sync.Mutex
+	logs     []storedLog
+	requests []listLogsRequest
+}
+
+func handleListLogs(t *testing.T, server *logServer) {
+	th.Mux.HandleFunc("/groups/group-id/streams/stream-id/content/query", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		var req listLogsRequest
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&req))
+		start, _ := strconv.ParseInt(req.StartTime, 10, 64)
+		end, _ := strconv.ParseInt(req.EndTime, 10, 64)
+
+		server.mu.Lock()
+		server.requests = append(server.requests, req)
+		var matched []storedLog
+		for _, log := range server.logs {
+			if log.time >= start && log.time <= end && strings.Contains(log.content, req.Keywords) {
+				matched = append(matched, log)
+			}
+		}
+		server.mu.Unlock()
+
+		sort.Slice(matched, func(i, j int) bool {
+			if req.IsDesc {
+				return matched[i].lineNum > matched[j].lineNum
+			}
+			return matched[i].lineNum < matched[j].lineNum
+		})
+		if req.LineNum != "" {
+			th.AssertEquals(t, map[bool]string{false: "forwards", true: "backwards"}[req.IsDesc], req.SearchType)
+			for i, log := range matched {
+				if log.lineNum == req.LineNum {
+					matched = matched[i:]
+					break
+				}
+			}
+		}
+		if len(matched) > req.Limit {
+			matched = matched[:req.Limit]
+		}
+
+		var logs []string
+		for _, log := range matched {
+			logs = append(logs, fmt.Sprintf(`{"content": %q, "line_num": %q, "labels": {"hostName": "ecs-test"}}`, log.content, log.lineNum))
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"count": %d, "logs": [%s]}`, len(logs), strings.Join(logs, ","))
+	})
+}
+
+func (s *logServer) Add(time int64, content string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logs = append(s.logs, storedLog{time: time, lineNum: fmt.Sprintf("%013d%06d", time, len(s.logs)), content: content})
+}
+
+func (s *logServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
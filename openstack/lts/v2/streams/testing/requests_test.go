@@ -0,0 +1,206 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/streams"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+var searchStart = time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+func searchLogs(server *logServer) []string {
+	// three days of logs, some of them at the window boundaries
+	offsets := []time.Duration{
+		time.Hour, 2 * time.Hour, 3 * time.Hour,
+		24 * time.Hour, 24 * time.Hour,
+		30 * time.Hour,
+		48 * time.Hour,
+		60 * time.Hour, 61 * time.Hour, 62 * time.Hour,
+	}
+	var expected []string
+	for i, offset := range offsets {
+		content := fmt.Sprintf("event %d", i)
+		server.Add(searchStart.Add(offset).UnixMilli(), content)
+		expected = append(expected, content)
+	}
+	return expected
+}
+
+func collect(it *streams.SearchIterator) []string {
+	var contents []string
+	for it.Next() {
+		contents = append(contents, it.Log().Content)
+	}
+	return contents
+}
+
+func TestSearch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := &logServer{}
+	handleListLogs(t, server)
+	expected := searchLogs(server)
+
+	it := streams.Search(fake.ServiceClient(), streams.SearchOpts{
+		GroupId:   "group-id",
+		StreamId:  "stream-id",
+		StartTime: searchStart,
+		EndTime:   searchStart.Add(72 * time.Hour),
+		Limit:     2,
+	})
+	th.AssertDeepEquals(t, expected, collect(it))
+	th.AssertNoErr(t, it.Err())
+	th.AssertEquals(t, "ecs-test", it.Log().Labels["hostName"])
+}
+
+func TestSearchDesc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := &logServer{}
+	handleListLogs(t, server)
+	expected := searchLogs(server)
+
+	var reversed []string
+	for i := len(expected) - 1; i >= 0; i-- {
+		reversed = append(reversed, expected[i])
+	}
+
+	it := streams.Search(fake.ServiceClient(), streams.SearchOpts{
+		GroupId:   "group-id",
+		StreamId:  "stream-id",
+		StartTime: searchStart,
+		EndTime:   searchStart.Add(72 * time.Hour),
+		IsDesc:    true,
+		Limit:     3,
+	})
+	th.AssertDeepEquals(t, reversed, collect(it))
+	th.AssertNoErr(t, it.Err())
+}
+
+func TestSearchLazy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := &logServer{}
+	handleListLogs(t, server)
+	searchLogs(server)
+
+	it := streams.Search(fake.ServiceClient(), streams.SearchOpts{
+		GroupId:   "group-id",
+		StreamId:  "stream-id",
+		StartTime: searchStart,
+		EndTime:   searchStart.Add(72 * time.Hour),
+		Keywords:  "event 1",
+	})
+	th.AssertEquals(t, 0, server.Requests())
+
+	th.AssertEquals(t, true, it.Next())
+	th.AssertEquals(t, "event 1", it.Log().Content)
+	th.AssertEquals(t, 1, server.Requests())
+}
+
+func TestSearchError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/groups/group-id/streams/stream-id/content/query", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	it := streams.Search(fake.ServiceClient(), streams.SearchOpts{
+		GroupId:   "group-id",
+		StreamId:  "stream-id",
+		StartTime: searchStart,
+	})
+	th.AssertEquals(t, false, it.Next())
+	if _, ok := it.Err().(golangsdk.ErrDefault400); !ok {
+		t.Fatalf("expected ErrDefault400, got %v", it.Err())
+	}
+}
+
+func TestSearchDefaultStart(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := &logServer{}
+	handleListLogs(t, server)
+
+	end := searchStart.Add(72 * time.Hour)
+	server.Add(end.Add(-streams.MaxSearchWindow-time.Hour).UnixMilli(), "too old")
+	server.Add(end.Add(-streams.MaxSearchWindow).UnixMilli(), "oldest")
+	server.Add(end.Add(-time.Hour).UnixMilli(), "newest")
+
+	it := streams.Search(fake.ServiceClient(), streams.SearchOpts{
+		GroupId:  "group-id",
+		StreamId: "stream-id",
+		EndTime:  end,
+	})
+	th.AssertDeepEquals(t, []string{"oldest", "newest"}, collect(it))
+	th.AssertNoErr(t, it.Err())
+	th.AssertEquals(t, strconv.FormatInt(end.Add(-streams.MaxSearchWindow).UnixMilli(), 10), server.requests[0].StartTime)
+	th.AssertEquals(t, strconv.FormatInt(end.UnixMilli(), 10), server.requests[len(server.requests)-1].EndTime)
+}
+
+func TestSearchInvalidRange(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := &logServer{}
+	handleListLogs(t, server)
+
+	it := streams.Search(fake.ServiceClient(), streams.SearchOpts{
+		GroupId:   "group-id",
+		StreamId:  "stream-id",
+		StartTime: searchStart.Add(time.Hour),
+		EndTime:   searchStart,
+	})
+	th.AssertEquals(t, false, it.Next())
+	th.AssertEquals(t, "start time 2023-05-01T01:00:00Z is after end time 2023-05-01T00:00:00Z", it.Err().Error())
+	th.AssertEquals(t, 0, server.Requests())
+}
+
+func TestTail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	server := &logServer{}
+	handleListLogs(t, server)
+	server.Add(time.Now().Add(-2*time.Hour).UnixMilli(), "error: too old")
+	server.Add(time.Now().Add(-time.Minute).UnixMilli(), "error: recent")
+	server.Add(time.Now().Add(-time.Minute).UnixMilli(), "info: recent")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logs, errs := streams.TailWithOpts(ctx, fake.ServiceClient(), streams.TailOpts{
+		GroupId:      "group-id",
+		StreamId:     "stream-id",
+		Keywords:     "error",
+		PollInterval: 10 * time.Millisecond,
+		Since:        time.Hour,
+	})
+
+	receive := func() string {
+		select {
+		case log := <-logs:
+			return log.Content
+		case err := <-errs:
+			t.Fatalf("unexpected error: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no log received")
+		}
+		return ""
+	}
+
+	th.AssertEquals(t, "error: recent", receive())
+	server.Add(time.Now().UnixMilli(), "error: new")
+	th.AssertEquals(t, "error: new", receive())
+
+	cancel()
+	for range logs {
+		t.Fatal("no more logs expected")
+	}
+	th.AssertNoErr(t, <-errs)
+}
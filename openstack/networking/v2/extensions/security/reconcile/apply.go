@@ -0,0 +1,94 @@
+package reconcile
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/multierr"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+)
+
+// ApplyError is returned when applying a plan failed. The changes made before
+// the failure are rolled back; RollbackErr is set if that failed too.
+type ApplyError struct {
+	Err         error
+	RollbackErr error
+}
+
+func (e *ApplyError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("%s; rollback failed: %s", e.Err, e.RollbackErr)
+	}
+	return e.Err.Error()
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
+
+// Apply adds and then removes the planned rules of the security group, so the
+// group never allows less traffic than both states have in common. When an
+// operation fails, rules created so far are deleted and rules deleted so far
+// are recreated.
+func Apply(client *golangsdk.ServiceClient, groupID string, plan *Plan) error {
+	var (
+		created []string
+		removed []rules.SecGroupRule
+	)
+
+	for _, opts := range plan.Add {
+		opts.SecGroupID = groupID
+		rule, err := rules.Create(client, opts).Extract()
+		if err != nil {
+			return &ApplyError{
+				Err:         fmt.Errorf("error creating rule %s: %w", KeyFromOpts(opts), err),
+				RollbackErr: rollback(client, created, removed),
+			}
+		}
+		created = append(created, rule.ID)
+	}
+
+	for _, rule := range plan.Remove {
+		if err := rules.Delete(client, rule.ID).ExtractErr(); err != nil {
+			return &ApplyError{
+				Err:         fmt.Errorf("error deleting rule %s (%s): %w", rule.ID, KeyFromRule(rule), err),
+				RollbackErr: rollback(client, created, removed),
+			}
+		}
+		removed = append(removed, rule)
+	}
+
+	return nil
+}
+
+func rollback(client *golangsdk.ServiceClient, created []string, removed []rules.SecGroupRule) error {
+	var errs multierr.MultiError
+
+	for _, rule := range removed {
+		_, err := rules.Create(client, optsFromRule(rule)).Extract()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error restoring rule %s: %w", KeyFromRule(rule), err))
+		}
+	}
+	for _, id := range created {
+		if err := rules.Delete(client, id).ExtractErr(); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting rule %s: %w", id, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func optsFromRule(rule rules.SecGroupRule) rules.CreateOpts {
+	return rules.CreateOpts{
+		Direction:      rules.RuleDirection(rule.Direction),
+		Description:    rule.Description,
+		EtherType:      rules.RuleEtherType(rule.EtherType),
+		SecGroupID:     rule.SecGroupID,
+		PortRangeMax:   rule.PortRangeMax,
+		PortRangeMin:   rule.PortRangeMin,
+		Protocol:       rules.RuleProtocol(rule.Protocol),
+		RemoteGroupID:  rule.RemoteGroupID,
+		RemoteIPPrefix: rule.RemoteIPPrefix,
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Package reconcile converges the rules of a security group to a desired state.
+
+Security group rules have no identity besides their ID, so rules are matched
+by a canonical Key built from direction, ethertype, protocol, port range,
+remote IP prefix and remote group.
+
+Example to Plan and Apply Security Group Rules
+
+	groupID := "85cc3048-abc3-43cc-89b3-377341426ac5"
+	desired := []rules.CreateOpts{
+		{
+			Direction:      rules.DirIngress,
+			EtherType:      rules.EtherType4,
+			Protocol:       rules.ProtocolTCP,
+			PortRangeMin:   pointerto.Int(22),
+			PortRangeMax:   pointerto.Int(22),
+			RemoteIPPrefix: "10.0.0.0/8",
+		},
+	}
+
+	plan, err := reconcile.PlanForGroup(networkClient, groupID, desired)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(plan)
+
+	if err := reconcile.Apply(networkClient, groupID, plan); err != nil {
+		panic(err)
+	}
+*/
+package reconcile
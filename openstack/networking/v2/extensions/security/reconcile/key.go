@@ -0,0 +1,111 @@
+package reconcile
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+)
+
+// Key is the canonical identity of a security group rule.
+//
+// Zero and missing ports both mean "any port", an empty protocol means "any
+// protocol" and remote IP prefixes are compared in their canonical network
+// form, with `0.0.0.0/0` and `::/0` being equal to no prefix.
+type Key struct {
+	Direction      string
+	EtherType      string
+	Protocol       string
+	PortRangeMin   int
+	PortRangeMax   int
+	RemoteIPPrefix string
+	RemoteGroupID  string
+}
+
+// KeyFromRule returns the Key of an existing rule.
+func KeyFromRule(rule rules.SecGroupRule) Key {
+	return newKey(rule.Direction, rule.EtherType, rule.Protocol, rule.PortRangeMin, rule.PortRangeMax,
+		rule.RemoteIPPrefix, rule.RemoteGroupID)
+}
+
+// KeyFromOpts returns the Key of a rule to be created.
+func KeyFromOpts(opts rules.CreateOpts) Key {
+	return newKey(string(opts.Direction), string(opts.EtherType), string(opts.Protocol), opts.PortRangeMin,
+		opts.PortRangeMax, opts.RemoteIPPrefix, opts.RemoteGroupID)
+}
+
+func newKey(direction, etherType, protocol string, portMin, portMax *int, prefix, group string) Key {
+	key := Key{
+		Direction:      strings.ToLower(direction),
+		EtherType:      strings.ToLower(etherType),
+		Protocol:       strings.ToLower(protocol),
+		RemoteIPPrefix: canonicalPrefix(prefix),
+		RemoteGroupID:  group,
+	}
+	if key.Protocol == "any" {
+		key.Protocol = ""
+	}
+	if portMin != nil {
+		key.PortRangeMin = *portMin
+	}
+	if portMax != nil {
+		key.PortRangeMax = *portMax
+	}
+	return key
+}
+
+func canonicalPrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+	if !strings.Contains(prefix, "/") {
+		if ip := net.ParseIP(prefix); ip != nil {
+			if ip.To4() != nil {
+				prefix += "/32"
+			} else {
+				prefix += "/128"
+			}
+		}
+	}
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return prefix
+	}
+	if ones, _ := network.Mask.Size(); ones == 0 {
+		return ""
+	}
+	return network.String()
+}
+
+// String returns a human-readable representation of the key, e.g.
+// `ingress IPv4 tcp 22-22 from 10.0.0.0/8`.
+func (k Key) String() string {
+	protocol := k.Protocol
+	if protocol == "" {
+		protocol = "any"
+	}
+	ports := "any"
+	if k.PortRangeMin != 0 || k.PortRangeMax != 0 {
+		ports = fmt.Sprintf("%d-%d", k.PortRangeMin, k.PortRangeMax)
+	}
+	remote := "any"
+	switch {
+	case k.RemoteGroupID != "":
+		remote = "group " + k.RemoteGroupID
+	case k.RemoteIPPrefix != "":
+		remote = k.RemoteIPPrefix
+	}
+	preposition := "from"
+	if k.Direction == string(rules.DirEgress) {
+		preposition = "to"
+	}
+	etherType := k.EtherType
+	switch etherType {
+	case "ipv4":
+		etherType = string(rules.EtherType4)
+	case "ipv6":
+		etherType = string(rules.EtherType6)
+	}
+	return fmt.Sprintf("%s %s %s %s %s %s", k.Direction, etherType, protocol, ports, preposition, remote)
+}
@@ -0,0 +1,96 @@
+package reconcile
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+)
+
+// Plan contains the rules to be added to and removed from a security group.
+type Plan struct {
+	Add    []rules.CreateOpts
+	Remove []rules.SecGroupRule
+}
+
+// IsEmpty returns true if the security group is already in the desired state.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Add) == 0 && len(p.Remove) == 0
+}
+
+// String returns the plan as a sorted list of `+ <key>` and `- <key>` lines,
+// suitable for code review.
+func (p *Plan) String() string {
+	lines := make([]string, 0, len(p.Add)+len(p.Remove))
+	for _, rule := range p.Remove {
+		lines = append(lines, "- "+KeyFromRule(rule).String())
+	}
+	for _, opts := range p.Add {
+		lines = append(lines, "+ "+KeyFromOpts(opts).String())
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i][2:] < lines[j][2:]
+	})
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Diff computes the rules to be added and removed to converge live rules to
+// the desired ones. Duplicated desired and live rules are collapsed, the
+// duplicates of a live rule are removed.
+func Diff(groupID string, desired []rules.CreateOpts, live []rules.SecGroupRule) *Plan {
+	plan := &Plan{}
+
+	wanted := make(map[Key]bool, len(desired))
+	for _, opts := range desired {
+		key := KeyFromOpts(opts)
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+		opts.SecGroupID = groupID
+		plan.Add = append(plan.Add, opts)
+	}
+
+	existing := make(map[Key]bool, len(live))
+	for _, rule := range live {
+		key := KeyFromRule(rule)
+		if !wanted[key] || existing[key] {
+			plan.Remove = append(plan.Remove, rule)
+			continue
+		}
+		existing[key] = true
+	}
+
+	add := plan.Add[:0]
+	for _, opts := range plan.Add {
+		if !existing[KeyFromOpts(opts)] {
+			add = append(add, opts)
+		}
+	}
+	plan.Add = add
+
+	return plan
+}
+
+// ListRules returns all rules of the security group.
+func ListRules(client *golangsdk.ServiceClient, groupID string) ([]rules.SecGroupRule, error) {
+	pages, err := rules.List(client, rules.ListOpts{SecGroupID: groupID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return rules.ExtractRules(pages)
+}
+
+// PlanForGroup lists the rules of the security group and computes the plan
+// converging them to the desired ones.
+func PlanForGroup(client *golangsdk.ServiceClient, groupID string, desired []rules.CreateOpts) (*Plan, error) {
+	live, err := ListRules(client, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(groupID, desired, live), nil
+}
@@ -0,0 +1,2 @@
+// reconcile unit tests
+package testing
@@ -0,0 +1,182 @@
+package testing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/pointerto"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/common"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/reconcile"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const groupID = "85cc3048-abc3-43cc-89b3-377341426ac5"
+
+var liveRules = []rules.SecGroupRule{
+	{
+		ID:         "egress-v4",
+		Direction:  "egress",
+		EtherType:  "IPv4",
+		SecGroupID: groupID,
+	},
+	{
+		ID:             "ssh",
+		Direction:      "ingress",
+		EtherType:      "IPv4",
+		Protocol:       "tcp",
+		PortRangeMin:   pointerto.Int(22),
+		PortRangeMax:   pointerto.Int(22),
+		RemoteIPPrefix: "10.1.2.3/8",
+		SecGroupID:     groupID,
+	},
+	{
+		ID:             "ssh-duplicate",
+		Direction:      "ingress",
+		EtherType:      "IPv4",
+		Protocol:       "tcp",
+		PortRangeMin:   pointerto.Int(22),
+		PortRangeMax:   pointerto.Int(22),
+		RemoteIPPrefix: "10.0.0.0/8",
+		SecGroupID:     groupID,
+	},
+	{
+		ID:           "http",
+		Direction:    "ingress",
+		EtherType:    "IPv4",
+		Protocol:     "tcp",
+		PortRangeMin: pointerto.Int(80),
+		PortRangeMax: pointerto.Int(80),
+		SecGroupID:   groupID,
+	},
+}
+
+var desiredRules = []rules.CreateOpts{
+	{
+		Direction:      rules.DirEgress,
+		EtherType:      rules.EtherType4,
+		RemoteIPPrefix: "0.0.0.0/0",
+		PortRangeMin:   pointerto.Int(0),
+	},
+	{
+		Direction:      rules.DirIngress,
+		EtherType:      rules.EtherType4,
+		Protocol:       rules.ProtocolTCP,
+		PortRangeMin:   pointerto.Int(22),
+		PortRangeMax:   pointerto.Int(22),
+		RemoteIPPrefix: "10.0.0.0/8",
+	},
+	{
+		Direction:     rules.DirIngress,
+		EtherType:     rules.EtherType4,
+		Protocol:      rules.ProtocolTCP,
+		PortRangeMin:  pointerto.Int(443),
+		PortRangeMax:  pointerto.Int(443),
+		RemoteGroupID: groupID,
+	},
+	{
+		Direction:     rules.DirIngress,
+		EtherType:     rules.EtherType4,
+		Protocol:      rules.ProtocolTCP,
+		PortRangeMin:  pointerto.Int(443),
+		PortRangeMax:  pointerto.Int(443),
+		RemoteGroupID: groupID,
+	},
+}
+
+func TestKey(t *testing.T) {
+	th.CheckEquals(t, reconcile.KeyFromRule(liveRules[1]), reconcile.KeyFromOpts(desiredRules[1]))
+	th.CheckEquals(t, reconcile.KeyFromRule(liveRules[0]), reconcile.KeyFromOpts(desiredRules[0]))
+	th.CheckEquals(t, "ingress IPv4 tcp 22-22 from 10.0.0.0/8", reconcile.KeyFromRule(liveRules[1]).String())
+	th.CheckEquals(t, "egress IPv4 any any to any", reconcile.KeyFromRule(liveRules[0]).String())
+}
+
+func TestDiff(t *testing.T) {
+	plan := reconcile.Diff(groupID, desiredRules, liveRules)
+
+	th.AssertEquals(t, 1, len(plan.Add))
+	th.CheckEquals(t, groupID, plan.Add[0].SecGroupID)
+	th.CheckEquals(t, 443, *plan.Add[0].PortRangeMin)
+	th.AssertEquals(t, 2, len(plan.Remove))
+	th.CheckEquals(t, "ssh-duplicate", plan.Remove[0].ID)
+	th.CheckEquals(t, "http", plan.Remove[1].ID)
+	th.CheckEquals(t, "- ingress IPv4 tcp 22-22 from 10.0.0.0/8\n"+
+		"+ ingress IPv4 tcp 443-443 from group "+groupID+"\n"+
+		"- ingress IPv4 tcp 80-80 from any\n", plan.String())
+
+	th.CheckEquals(t, true, reconcile.Diff(groupID, desiredRules[:2], liveRules[:2]).IsEmpty())
+}
+
+func handleRules(t *testing.T, failDelete string) (created, deleted *[]string) {
+	created, deleted = new([]string), new([]string)
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"security_group_id": groupID})
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"security_group_rules": liveRules})
+		case "POST":
+			var body struct {
+				Rule map[string]interface{} `json:"security_group_rule"`
+			}
+			th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+			id := fmt.Sprintf("new-%d", len(*created))
+			*created = append(*created, fmt.Sprint(body.Rule["port_range_min"]))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `{"security_group_rule": {"id": "%s"}}`, id)
+		}
+	})
+	for _, rule := range liveRules {
+		id := rule.ID
+		th.Mux.HandleFunc("/v2.0/security-group-rules/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			if id == failDelete {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			*deleted = append(*deleted, id)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	th.Mux.HandleFunc("/v2.0/security-group-rules/new-0", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		*deleted = append(*deleted, "new-0")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return
+}
+
+func TestApply(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	created, deleted := handleRules(t, "")
+
+	plan, err := reconcile.PlanForGroup(fake.ServiceClient(), groupID, desiredRules)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, reconcile.Apply(fake.ServiceClient(), groupID, plan))
+	th.CheckDeepEquals(t, []string{"443"}, *created)
+	th.CheckDeepEquals(t, []string{"ssh-duplicate", "http"}, *deleted)
+}
+
+func TestApplyRollback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	created, deleted := handleRules(t, "http")
+
+	plan, err := reconcile.PlanForGroup(fake.ServiceClient(), groupID, desiredRules)
+	th.AssertNoErr(t, err)
+
+	err = reconcile.Apply(fake.ServiceClient(), groupID, plan)
+	var applyErr *reconcile.ApplyError
+	th.AssertEquals(t, true, errors.As(err, &applyErr))
+	th.AssertNoErr(t, applyErr.RollbackErr)
+	// the added rule is deleted and the removed duplicate recreated
+	th.CheckDeepEquals(t, []string{"443", "22"}, *created)
+	th.CheckDeepEquals(t, []string{"ssh-duplicate", "new-0"}, *deleted)
+}
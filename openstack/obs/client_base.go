@@ -1,6 +1,7 @@
 package obs
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -10,6 +11,7 @@ import (
 type ObsClient struct {
 	conf       *config
 	httpClient *http.Client
+	ctx        context.Context
 }
 
 // New creates a new ObsClient instance.
@@ -44,3 +46,28 @@ func New(ak, sk, endpoint string, configurers ...Configurer) (*ObsClient, error)
 	obsClient := &ObsClient{conf: conf, httpClient: &http.Client{Transport: conf.transport, CheckRedirect: checkRedirectFunc}}
 	return obsClient, nil
 }
+
+// WithContext returns a copy of the client sending all requests with ctx instead of the
+// context set by WithRequestContext. The copy shares the configuration and the connections
+// of the original client, so it is cheap to create one per call of any operation:
+//
+//	output, err := obsClient.WithContext(ctx).ListObjects(input)
+//
+// The body returned by GetObject is read with ctx as well, so cancelling ctx stops the download.
+// Cancelling ctx during UploadFile or DownloadFile stops the part workers; with EnableCheckpoint
+// set, the transfer can be resumed later from the checkpoint file.
+func (obsClient ObsClient) WithContext(ctx context.Context) *ObsClient {
+	obsClient.ctx = ctx
+	return &obsClient
+}
+
+// context returns the context of the requests sent by the client.
+func (obsClient ObsClient) context() context.Context {
+	if obsClient.ctx != nil {
+		return obsClient.ctx
+	}
+	if obsClient.conf.ctx != nil {
+		return obsClient.conf.ctx
+	}
+	return context.Background()
+}
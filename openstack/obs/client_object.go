@@ -175,12 +175,31 @@ func (obsClient ObsClient) GetObject(input *GetObjectInput) (output *GetObjectOu
 	if input == nil {
 		return nil, errors.New("GetObjectInput is nil")
 	}
+	// a tracker set by DownloadFile reports the progress of the whole file
+	tracker, ownTracker := input.tracker, false
+	if tracker == nil {
+		tracker = newProgressTracker(input.ProgressListener, obsClient.getRateLimiter(input.RateLimiter), -1)
+		ownTracker = tracker != nil
+	}
+	if ownTracker {
+		tracker.started()
+	}
+
 	output = &GetObjectOutput{}
 	err = obsClient.doActionWithBucketAndKey("GetObject", HTTP_GET, input.Bucket, input.Key, input, output)
 	if err != nil {
 		output = nil
+		if ownTracker {
+			tracker.finished(err)
+		}
 	} else {
 		ParseGetObjectOutput(output)
+		if tracker != nil {
+			if ownTracker {
+				tracker.setTotal(output.ContentLength)
+			}
+			output.Body = &progressReadCloser{ReadCloser: output.Body, tracker: tracker, own: ownTracker}
+		}
 	}
 	return
 }
@@ -197,19 +216,38 @@ func (obsClient ObsClient) PutObject(input *PutObjectInput) (output *PutObjectOu
 		}
 	}
 
+	// the body wrapper and the inferred body length are kept out of the caller's input,
+	// which may be reused with another body
+	copied := *input
+	input = &copied
+
 	output = &PutObjectOutput{}
 	var repeatable bool
+	var tracker *progressTracker
 	if input.Body != nil {
 		_, repeatable = input.Body.(*strings.Reader)
+		if input.ContentLength <= 0 {
+			// the wrapper hides the reader type from net/http, which would send a known length chunked
+			if length, ok := readerLength(input.Body); ok && length > 0 {
+				input.ContentLength = length
+			}
+		}
+		total := int64(-1)
 		if input.ContentLength > 0 {
-			input.Body = &readerWrapper{reader: input.Body, totalCount: input.ContentLength}
+			total = input.ContentLength
+		}
+		tracker = newProgressTracker(input.ProgressListener, obsClient.getRateLimiter(input.RateLimiter), total)
+		if input.ContentLength > 0 || tracker != nil {
+			input.Body = &readerWrapper{reader: input.Body, totalCount: total, tracker: tracker}
 		}
 	}
+	tracker.started()
 	if repeatable {
 		err = obsClient.doActionWithBucketAndKey("PutObject", HTTP_PUT, input.Bucket, input.Key, input, output)
 	} else {
 		err = obsClient.doActionWithBucketAndKeyUnRepeatable("PutObject", HTTP_PUT, input.Bucket, input.Key, input, output)
 	}
+	tracker.finished(err)
 	if err != nil {
 		output = nil
 	} else {
@@ -242,6 +280,7 @@ func (obsClient ObsClient) PutFile(input *PutFileInput) (output *PutObjectOutput
 	}
 
 	var body io.Reader
+	var tracker *progressTracker
 	sourceFile := strings.TrimSpace(input.SourceFile)
 	if sourceFile != "" {
 		fd, _err := os.Open(sourceFile)
@@ -271,6 +310,8 @@ func (obsClient ObsClient) PutFile(input *PutFileInput) (output *PutObjectOutput
 		} else {
 			fileReaderWrapper.totalCount = stat.Size()
 		}
+		tracker = newProgressTracker(input.ProgressListener, obsClient.getRateLimiter(input.RateLimiter), fileReaderWrapper.totalCount)
+		fileReaderWrapper.tracker = tracker
 		body = fileReaderWrapper
 	}
 
@@ -283,7 +324,9 @@ func (obsClient ObsClient) PutFile(input *PutFileInput) (output *PutObjectOutput
 	}
 
 	output = &PutObjectOutput{}
+	tracker.started()
 	err = obsClient.doActionWithBucketAndKey("PutFile", HTTP_PUT, _input.Bucket, _input.Key, _input, output)
+	tracker.finished(err)
 	if err != nil {
 		output = nil
 	} else {
@@ -316,3 +359,124 @@ func (obsClient ObsClient) CopyObject(input *CopyObjectInput) (output *CopyObjec
 	}
 	return
 }
+
+// SetObjectTagging sets object tags.
+//
+// You can use this API to set the tags of an object in a specified bucket, replacing the existing ones.
+func (obsClient ObsClient) SetObjectTagging(input *SetObjectTaggingInput) (output *BaseModel, err error) {
+	if input == nil {
+		return nil, errors.New("SetObjectTaggingInput is nil")
+	}
+	output = &BaseModel{}
+	err = obsClient.doActionWithBucketAndKey("SetObjectTagging", HTTP_PUT, input.Bucket, input.Key, input, output)
+	if err != nil {
+		output = nil
+	}
+	return
+}
+
+// GetObjectTagging gets object tags.
+//
+// You can use this API to obtain the tags of an object in a specified bucket.
+func (obsClient ObsClient) GetObjectTagging(input *GetObjectTaggingInput) (output *GetObjectTaggingOutput, err error) {
+	if input == nil {
+		return nil, errors.New("GetObjectTaggingInput is nil")
+	}
+	output = &GetObjectTaggingOutput{}
+	err = obsClient.doActionWithBucketAndKey("GetObjectTagging", HTTP_GET, input.Bucket, input.Key, input, output)
+	if err != nil {
+		output = nil
+	} else {
+		if versionId, ok := output.ResponseHeaders[HEADER_VERSION_ID]; ok {
+			output.VersionId = versionId[0]
+		}
+	}
+	return
+}
+
+// DeleteObjectTagging deletes object tags.
+//
+// You can use this API to delete the tags of an object in a specified bucket.
+func (obsClient ObsClient) DeleteObjectTagging(input *DeleteObjectTaggingInput) (output *BaseModel, err error) {
+	if input == nil {
+		return nil, errors.New("DeleteObjectTaggingInput is nil")
+	}
+	output = &BaseModel{}
+	err = obsClient.doActionWithBucketAndKey("DeleteObjectTagging", HTTP_DELETE, input.Bucket, input.Key, input, output)
+	if err != nil {
+		output = nil
+	}
+	return
+}
+
+// AppendObject uploads data to the end of an appendable object.
+//
+// The object is created by the first call with Position 0. Every following call has to use
+// the NextAppendPosition returned by the previous one.
+func (obsClient ObsClient) AppendObject(input *AppendObjectInput) (output *AppendObjectOutput, err error) {
+	if input == nil {
+		return nil, errors.New("AppendObjectInput is nil")
+	}
+	if input.Position < 0 {
+		return nil, errors.New("Position is negative")
+	}
+
+	if input.ContentType == "" && input.Key != "" {
+		if contentType, ok := mimeTypes[strings.ToLower(input.Key[strings.LastIndex(input.Key, ".")+1:])]; ok {
+			input.ContentType = contentType
+		}
+	}
+
+	output = &AppendObjectOutput{}
+	var repeatable bool
+	if input.Body != nil {
+		_, repeatable = input.Body.(*strings.Reader)
+		if input.ContentLength > 0 {
+			input.Body = &readerWrapper{reader: input.Body, totalCount: input.ContentLength}
+		}
+	}
+	if repeatable {
+		err = obsClient.doActionWithBucketAndKey("AppendObject", HTTP_POST, input.Bucket, input.Key, input, output)
+	} else {
+		err = obsClient.doActionWithBucketAndKeyUnRepeatable("AppendObject", HTTP_POST, input.Bucket, input.Key, input, output)
+	}
+	if err != nil {
+		output = nil
+	} else {
+		ParseAppendObjectOutput(output)
+	}
+	return
+}
+
+// SetObjectRetention sets the WORM retention of an object.
+//
+// The bucket has to be created with object lock enabled. The retention period can be
+// extended, but not shortened.
+func (obsClient ObsClient) SetObjectRetention(input *SetObjectRetentionInput) (output *BaseModel, err error) {
+	if input == nil {
+		return nil, errors.New("SetObjectRetentionInput is nil")
+	}
+	output = &BaseModel{}
+	err = obsClient.doActionWithBucketAndKey("SetObjectRetention", HTTP_PUT, input.Bucket, input.Key, input, output)
+	if err != nil {
+		output = nil
+	}
+	return
+}
+
+// GetObjectRetention gets the WORM retention of an object.
+//
+// The retention is read from the object metadata, Mode is empty if the object is not protected.
+func (obsClient ObsClient) GetObjectRetention(input *GetObjectRetentionInput) (output *GetObjectRetentionOutput, err error) {
+	if input == nil {
+		return nil, errors.New("GetObjectRetentionInput is nil")
+	}
+	output = &GetObjectRetentionOutput{}
+	err = obsClient.doActionWithBucketAndKey("GetObjectRetention", HTTP_HEAD, input.Bucket, input.Key, input, output)
+	if err != nil {
+		output = nil
+	} else {
+		ParseGetObjectRetentionOutput(output)
+	}
+	return
+}
@@ -87,12 +87,30 @@ func (obsClient ObsClient) UploadPart(_input *UploadPartInput) (output *UploadPa
 	input.SseHeader = _input.SseHeader
 	input.Body = _input.Body
 
+	// a tracker set by UploadFile reports the progress of the whole file
+	tracker, ownTracker := _input.tracker, false
+	if tracker == nil {
+		tracker = newProgressTracker(_input.ProgressListener, obsClient.getRateLimiter(_input.RateLimiter), -1)
+		ownTracker = tracker != nil
+	}
+
 	output = &UploadPartOutput{}
 	var repeatable bool
 	if input.Body != nil {
 		_, repeatable = input.Body.(*strings.Reader)
-		if _, ok := input.Body.(*readerWrapper); !ok && input.PartSize > 0 {
-			input.Body = &readerWrapper{reader: input.Body, totalCount: input.PartSize}
+		if wrapper, ok := input.Body.(*readerWrapper); ok {
+			if wrapper.tracker == nil {
+				wrapper.tracker = tracker
+			}
+		} else if input.PartSize > 0 || tracker != nil {
+			totalCount := input.PartSize
+			if totalCount <= 0 {
+				totalCount = -1
+			}
+			input.Body = &readerWrapper{reader: input.Body, totalCount: totalCount, tracker: tracker}
+		}
+		if ownTracker && input.PartSize > 0 {
+			tracker.setTotal(input.PartSize)
 		}
 	} else if sourceFile := strings.TrimSpace(input.SourceFile); sourceFile != "" {
 		fd, _err := os.Open(sourceFile)
@@ -124,17 +142,27 @@ func (obsClient ObsClient) UploadPart(_input *UploadPartInput) (output *UploadPa
 			input.PartSize = fileSize - input.Offset
 		}
 		fileReaderWrapper.totalCount = input.PartSize
+		fileReaderWrapper.tracker = tracker
+		if ownTracker {
+			tracker.setTotal(input.PartSize)
+		}
 		if _, err = fd.Seek(input.Offset, io.SeekStart); err != nil {
 			return nil, err
 		}
 		input.Body = fileReaderWrapper
 		repeatable = true
 	}
+	if ownTracker {
+		tracker.started()
+	}
 	if repeatable {
 		err = obsClient.doActionWithBucketAndKey("UploadPart", HTTP_PUT, input.Bucket, input.Key, input, output)
 	} else {
 		err = obsClient.doActionWithBucketAndKeyUnRepeatable("UploadPart", HTTP_PUT, input.Bucket, input.Key, input, output)
 	}
+	if ownTracker {
+		tracker.finished(err)
+	}
 	if err != nil {
 		output = nil
 	} else {
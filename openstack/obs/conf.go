@@ -48,6 +48,7 @@ type config struct {
 	transport        *http.Transport
 	ctx              context.Context
 	maxRedirectCount int
+	rateLimiter      *RateLimiter
 }
 
 func (conf config) String() string {
@@ -163,12 +164,21 @@ func WithHttpTransport(transport *http.Transport) Configurer {
 }
 
 // WithRequestContext is a configurer for ObsClient to set the context for each HTTP request.
+// Use ObsClient.WithContext to scope a context to a single call instead.
 func WithRequestContext(ctx context.Context) Configurer {
 	return func(conf *config) {
 		conf.ctx = ctx
 	}
 }
 
+// WithRateLimit is a configurer for ObsClient to limit the combined bandwidth of all transfers
+// to bytesPerSecond. It can be overridden per call with the RateLimiter field of the transfer input.
+func WithRateLimit(bytesPerSecond int64) Configurer {
+	return func(conf *config) {
+		conf.rateLimiter = NewRateLimiter(bytesPerSecond)
+	}
+}
+
 // WithCustomDomainName is a configurer for ObsClient.
 func WithCustomDomainName(cname bool) Configurer {
 	return func(conf *config) {
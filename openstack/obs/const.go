@@ -92,8 +92,10 @@ const (
 
 	HEADER_SUCCESS_ACTION_REDIRECT = "success_action_redirect"
 
-	HEADER_FS_FILE_INTERFACE   = "fs-file-interface"
-	HEADER_OBJECT_LOCK_ENABLED = "bucket-object-lock-enabled"
+	HEADER_FS_FILE_INTERFACE             = "fs-file-interface"
+	HEADER_OBJECT_LOCK_ENABLED           = "bucket-object-lock-enabled"
+	HEADER_OBJECT_LOCK_MODE              = "object-lock-mode"
+	HEADER_OBJECT_LOCK_RETAIN_UNTIL_DATE = "object-lock-retain-until-date"
 
 	HEADER_DATE_CAMEL                          = "Date"
 	HEADER_HOST_CAMEL                          = "Host"
@@ -113,6 +115,7 @@ const (
 	HEADER_EXPIRES_CAMEL                       = "Expires"
 
 	PARAM_VERSION_ID                   = "versionId"
+	PARAM_POSITION                     = "position"
 	PARAM_RESPONSE_CONTENT_TYPE        = "response-content-type"
 	PARAM_RESPONSE_CONTENT_LANGUAGE    = "response-content-language"
 	PARAM_RESPONSE_EXPIRES             = "response-expires"
@@ -201,7 +204,12 @@ var (
 		"location":                      true,
 		"date":                          true,
 		"etag":                          true,
+		"range":                         true,
 		"host":                          true,
+		"if-modified-since":             true,
+		"if-unmodified-since":           true,
+		"if-match":                      true,
+		"if-none-match":                 true,
 		"last-modified":                 true,
 		"content-range":                 true,
 		"x-reserved":                    true,
@@ -244,6 +252,7 @@ var (
 		"inventory":                    true,
 		"tagging":                      true,
 		"append":                       true,
+		"retention":                    true,
 		"position":                     true,
 		"replication":                  true,
 		"response-content-type":        true,
@@ -670,6 +679,8 @@ const (
 	SubResourceCustomDomain  SubResourceType  = "customdomain"
 	SubResourceInventory     SubResourceType  = "inventory"
 	SubResourceTagging       SubResourceType  = "tagging"
+	SubResourceAppend        SubResourceType  = "append"
+	SubResourceRetention     SubResourceType  = "retention"
 	SubResourceDelete        SubResourceType  = "delete"
 	SubResourceVersions      SubResourceType  = "versions"
 	SubResourceUploads       SubResourceType  = "uploads"
@@ -662,6 +662,37 @@ func ParsePutObjectOutput(output *PutObjectOutput) {
 	}
 }
 
+// ParseAppendObjectOutput sets AppendObjectOutput field values with response headers
+func ParseAppendObjectOutput(output *AppendObjectOutput) {
+	if ret, ok := output.ResponseHeaders[HEADER_VERSION_ID]; ok {
+		output.VersionId = ret[0]
+	}
+	output.SseHeader = parseSseHeader(output.ResponseHeaders)
+	if ret, ok := output.ResponseHeaders[HEADER_ETAG]; ok {
+		output.ETag = ret[0]
+	}
+	if ret, ok := output.ResponseHeaders[HEADER_NEXT_APPEND_POSITION]; ok {
+		output.NextAppendPosition = StringToInt64(ret[0], 0)
+	}
+}
+
+// ParseGetObjectRetentionOutput sets GetObjectRetentionOutput field values with response headers
+func ParseGetObjectRetentionOutput(output *GetObjectRetentionOutput) {
+	if ret, ok := output.ResponseHeaders[HEADER_VERSION_ID]; ok {
+		output.VersionId = ret[0]
+	}
+	if ret, ok := output.ResponseHeaders[HEADER_OBJECT_LOCK_MODE]; ok {
+		output.Mode = ret[0]
+	}
+	if ret, ok := output.ResponseHeaders[HEADER_OBJECT_LOCK_RETAIN_UNTIL_DATE]; ok {
+		if date, err := time.Parse(time.RFC3339, ret[0]); err == nil {
+			output.RetainUntilDate = date.UnixNano() / int64(time.Millisecond)
+		} else {
+			output.RetainUntilDate = StringToInt64(ret[0], 0)
+		}
+	}
+}
+
 // ParseInitiateMultipartUploadOutput sets InitiateMultipartUploadOutput field values with response headers
 func ParseInitiateMultipartUploadOutput(output *InitiateMultipartUploadOutput) {
 	output.SseHeader = parseSseHeader(output.ResponseHeaders)
@@ -0,0 +1,195 @@
+package encryption
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	tagSize   = 16
+	nonceSize = 12
+
+	// DefaultChunkSize is the size of the plaintext chunks encrypted separately.
+	DefaultChunkSize = 64 * 1024
+	// MaxChunkSize is the maximal supported chunk size.
+	MaxChunkSize = 16 * 1024 * 1024
+)
+
+var errTruncated = errors.New("encrypted object is truncated")
+
+// The plaintext is split into chunks of the same size, only the last one may be shorter. Every chunk
+// is sealed separately with AES-GCM, so it grows by the tag size. The nonce of a chunk is the object
+// nonce XOR-ed with the chunk index, the additional data contains the index and whether the chunk is
+// the last one, so that chunks can't be reordered and the object can't be truncated at a chunk
+// boundary. An empty plaintext is stored as a single empty chunk.
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(nonce []byte, index int64) []byte {
+	result := make([]byte, nonceSize)
+	copy(result, nonce)
+	counter := binary.BigEndian.Uint64(result[nonceSize-8:]) ^ uint64(index)
+	binary.BigEndian.PutUint64(result[nonceSize-8:], counter)
+	return result
+}
+
+func chunkAdditionalData(index int64, last bool) []byte {
+	data := make([]byte, 9)
+	binary.BigEndian.PutUint64(data, uint64(index))
+	if last {
+		data[8] = 1
+	}
+	return data
+}
+
+// chunkCount returns the number of chunks of a plaintext.
+func chunkCount(plaintextSize, chunkSize int64) int64 {
+	if plaintextSize == 0 {
+		return 1
+	}
+	return (plaintextSize + chunkSize - 1) / chunkSize
+}
+
+// encryptedSize returns the size of an encrypted plaintext.
+func encryptedSize(plaintextSize, chunkSize int64) int64 {
+	return plaintextSize + chunkCount(plaintextSize, chunkSize)*tagSize
+}
+
+// plaintextSize returns the size of the plaintext of an encrypted object.
+func plaintextSize(encryptedSize, chunkSize int64) (int64, error) {
+	chunks := (encryptedSize + chunkSize + tagSize - 1) / (chunkSize + tagSize)
+	size := encryptedSize - chunks*tagSize
+	if chunks == 0 || size < 0 || chunkCount(size, chunkSize) != chunks {
+		return 0, fmt.Errorf("invalid encrypted object size %d", encryptedSize)
+	}
+	return size, nil
+}
+
+type encryptReader struct {
+	src       *bufio.Reader
+	aead      cipher.AEAD
+	nonce     []byte
+	chunkSize int
+	index     int64
+	plaintext []byte
+	pending   []byte
+	done      bool
+}
+
+func newEncryptReader(src io.Reader, aead cipher.AEAD, nonce []byte, chunkSize int) *encryptReader {
+	return &encryptReader{
+		src:       bufio.NewReader(src),
+		aead:      aead,
+		nonce:     nonce,
+		chunkSize: chunkSize,
+		plaintext: make([]byte, chunkSize),
+	}
+}
+
+func (r *encryptReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(r.src, r.plaintext)
+		last := false
+		switch err {
+		case nil:
+			// peek, so that the last chunk is sealed as such
+			if _, err := r.src.Peek(1); err == io.EOF {
+				last = true
+			} else if err != nil {
+				return 0, err
+			}
+		case io.EOF, io.ErrUnexpectedEOF:
+			last = true
+		default:
+			return 0, err
+		}
+		r.pending = r.aead.Seal(r.pending[:0], chunkNonce(r.nonce, r.index), r.plaintext[:n], chunkAdditionalData(r.index, last))
+		r.index++
+		r.done = last
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// decryptReader decrypts the chunks [index, lastIndex] of an object and returns length bytes
+// starting skip bytes after the beginning of the first chunk.
+type decryptReader struct {
+	src           io.ReadCloser
+	aead          cipher.AEAD
+	nonce         []byte
+	chunkSize     int64
+	encryptedSize int64
+	index         int64
+	lastIndex     int64
+	skip          int64
+	remaining     int64
+	buf           []byte
+	pending       []byte
+}
+
+func newDecryptReader(src io.ReadCloser, aead cipher.AEAD, nonce []byte, chunkSize, encryptedSize, start, length int64) *decryptReader {
+	return &decryptReader{
+		src:           src,
+		aead:          aead,
+		nonce:         nonce,
+		chunkSize:     chunkSize,
+		encryptedSize: encryptedSize,
+		index:         start / chunkSize,
+		lastIndex:     (encryptedSize - 1) / (chunkSize + tagSize),
+		skip:          start % chunkSize,
+		remaining:     length,
+		buf:           make([]byte, chunkSize+tagSize),
+	}
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.remaining == 0 {
+			return 0, io.EOF
+		}
+		size := r.chunkSize + tagSize
+		if r.index == r.lastIndex {
+			size = r.encryptedSize - r.lastIndex*(r.chunkSize+tagSize)
+		}
+		if _, err := io.ReadFull(r.src, r.buf[:size]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				err = errTruncated
+			}
+			return 0, err
+		}
+		plaintext, err := r.aead.Open(r.buf[:0], chunkNonce(r.nonce, r.index), r.buf[:size], chunkAdditionalData(r.index, r.index == r.lastIndex))
+		if err != nil {
+			return 0, fmt.Errorf("error decrypting chunk %d: %w", r.index, err)
+		}
+		plaintext = plaintext[r.skip:]
+		if int64(len(plaintext)) > r.remaining {
+			plaintext = plaintext[:r.remaining]
+		}
+		r.pending = plaintext
+		r.remaining -= int64(len(plaintext))
+		r.index++
+		r.skip = 0
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *decryptReader) Close() error {
+	return r.src.Close()
+}
@@ -0,0 +1,289 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+)
+
+const (
+	// Algorithm identifies the encryption format in the object metadata.
+	Algorithm = "AES256-GCM-CHUNKED"
+
+	MetadataKey       = "encryption-key"
+	MetadataKeyID     = "encryption-key-id"
+	MetadataAlgorithm = "encryption-algorithm"
+	MetadataChunkSize = "encryption-chunk-size"
+	MetadataNonce     = "encryption-nonce"
+)
+
+// ErrNotEncrypted is returned when an object has no client-side encryption metadata.
+var ErrNotEncrypted = errors.New("object is not encrypted on the client side")
+
+// Client wraps an ObsClient, encrypting objects before upload and decrypting them after download.
+//
+// Every object is encrypted with its own data key, which is stored in the object metadata
+// wrapped by the master key of the KeyProvider.
+type Client struct {
+	obsClient *obs.ObsClient
+	keys      KeyProvider
+
+	// ChunkSize is the size of the plaintext chunks encrypted separately, DefaultChunkSize if not set.
+	// Range reads download whole chunks.
+	ChunkSize int
+}
+
+// NewClient creates a Client encrypting objects with data keys of keyProvider.
+func NewClient(obsClient *obs.ObsClient, keyProvider KeyProvider) *Client {
+	return &Client{obsClient: obsClient, keys: keyProvider}
+}
+
+// PutObject encrypts input.Body and uploads it. The encryption metadata is added to input.Metadata;
+// input.ContentMD5 is ignored, as it can't match the encrypted content.
+func (c *Client) PutObject(input *obs.PutObjectInput) (*obs.PutObjectOutput, error) {
+	if input == nil {
+		return nil, errors.New("PutObjectInput is nil")
+	}
+	chunkSize := c.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize < 0 || chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("chunk size must be between 1 and %d", MaxChunkSize)
+	}
+
+	dataKey, err := c.keys.GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	_input := *input
+	_input.ContentMD5 = ""
+	_input.Metadata = make(map[string]string, len(input.Metadata)+5)
+	for k, v := range input.Metadata {
+		_input.Metadata[k] = v
+	}
+	_input.Metadata[MetadataKey] = dataKey.Wrapped
+	_input.Metadata[MetadataKeyID] = dataKey.KeyID
+	_input.Metadata[MetadataAlgorithm] = Algorithm
+	_input.Metadata[MetadataChunkSize] = strconv.Itoa(chunkSize)
+	_input.Metadata[MetadataNonce] = base64.StdEncoding.EncodeToString(nonce)
+
+	var body io.Reader = input.Body
+	if body == nil {
+		body = bytes.NewReader(nil)
+	}
+	if input.ContentLength > 0 {
+		body = io.LimitReader(body, input.ContentLength)
+		_input.ContentLength = encryptedSize(input.ContentLength, int64(chunkSize))
+	}
+	_input.Body = newEncryptReader(body, aead, nonce, chunkSize)
+
+	return c.obsClient.PutObject(&_input)
+}
+
+// PutFile encrypts a local file and uploads it.
+func (c *Client) PutFile(input *obs.PutFileInput) (*obs.PutObjectOutput, error) {
+	if input == nil {
+		return nil, errors.New("PutFileInput is nil")
+	}
+	fd, err := os.Open(input.SourceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	stat, err := fd.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	_input := &obs.PutObjectInput{
+		PutObjectBasicInput: input.PutObjectBasicInput,
+		Body:                fd,
+		ProgressListener:    input.ProgressListener,
+		RateLimiter:         input.RateLimiter,
+	}
+	_input.ContentLength = stat.Size()
+	if _input.ContentType == "" {
+		_input.ContentType = mime.TypeByExtension(filepath.Ext(input.SourceFile))
+	}
+	return c.PutObject(_input)
+}
+
+// GetObject downloads an object and decrypts its body. input.RangeStart and input.RangeEnd refer
+// to the plaintext; the object metadata is fetched first to map them to the encrypted chunks.
+//
+// ContentLength of the output is the plaintext length. Objects without encryption metadata
+// result in ErrNotEncrypted.
+func (c *Client) GetObject(input *obs.GetObjectInput) (*obs.GetObjectOutput, error) {
+	if input == nil {
+		return nil, errors.New("GetObjectInput is nil")
+	}
+	// the same condition as used for sending the Range header
+	if input.RangeStart < 0 || input.RangeEnd <= input.RangeStart {
+		output, err := c.obsClient.GetObject(input)
+		if err != nil {
+			return nil, err
+		}
+		params, err := c.objectParams(output.Metadata, output.ContentLength)
+		if err != nil {
+			_ = output.Body.Close()
+			return nil, err
+		}
+		output.Body = newDecryptReader(output.Body, params.aead, params.nonce, params.chunkSize, output.ContentLength, 0, params.size)
+		output.ContentLength = params.size
+		return output, nil
+	}
+
+	metadata, err := c.obsClient.GetObjectMetadata(&input.GetObjectMetadataInput)
+	if err != nil {
+		return nil, err
+	}
+	params, err := c.objectParams(metadata.Metadata, metadata.ContentLength)
+	if err != nil {
+		return nil, err
+	}
+	start, end := input.RangeStart, input.RangeEnd
+	if end >= params.size {
+		end = params.size - 1
+	}
+	if start > end {
+		return nil, fmt.Errorf("range start %d is beyond the object size %d", start, params.size)
+	}
+
+	chunkSize := params.chunkSize + tagSize
+	_input := *input
+	_input.RangeStart = start / params.chunkSize * chunkSize
+	_input.RangeEnd = (end/params.chunkSize+1)*chunkSize - 1
+	if _input.RangeEnd >= metadata.ContentLength {
+		_input.RangeEnd = metadata.ContentLength - 1
+	}
+	if _input.IfMatch == "" {
+		// make sure the object wasn't replaced after reading its metadata
+		_input.IfMatch = metadata.ETag
+	}
+	output, err := c.obsClient.GetObject(&_input)
+	if err != nil {
+		return nil, err
+	}
+	output.Body = newDecryptReader(output.Body, params.aead, params.nonce, params.chunkSize, metadata.ContentLength, start, end-start+1)
+	output.ContentLength = end - start + 1
+	return output, nil
+}
+
+// DownloadFile downloads an object with obs.ObsClient.DownloadFile and decrypts it. The encrypted
+// object is downloaded to input.DownloadFile with the ".encrypted" suffix first, so that interrupted
+// downloads can be resumed with checkpoints, and is removed after decryption.
+//
+// ContentLength of the output is the plaintext length. Objects without encryption metadata
+// result in ErrNotEncrypted.
+func (c *Client) DownloadFile(input *obs.DownloadFileInput) (*obs.GetObjectMetadataOutput, error) {
+	if input == nil {
+		return nil, errors.New("DownloadFileInput is nil")
+	}
+	downloadFile := input.DownloadFile
+	if downloadFile == "" {
+		downloadFile = input.Key
+	}
+	_input := *input
+	_input.DownloadFile = downloadFile + ".encrypted"
+
+	output, err := c.obsClient.DownloadFile(&_input)
+	if err != nil {
+		return nil, err
+	}
+	params, err := c.objectParams(output.Metadata, output.ContentLength)
+	if err == nil {
+		err = decryptFile(_input.DownloadFile, downloadFile, params, output.ContentLength)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(_input.DownloadFile); err != nil {
+		return nil, err
+	}
+	output.ContentLength = params.size
+	return output, nil
+}
+
+func decryptFile(source, target string, params *objectParams, encryptedSize int64) error {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	reader := newDecryptReader(src, params.aead, params.nonce, params.chunkSize, encryptedSize, 0, params.size)
+	defer reader.Close()
+
+	tempFile := target + ".tmp"
+	dst, err := os.OpenFile(tempFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, reader)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempFile)
+		return err
+	}
+	return os.Rename(tempFile, target)
+}
+
+type objectParams struct {
+	aead      cipher.AEAD
+	nonce     []byte
+	chunkSize int64
+	size      int64
+}
+
+// objectParams reads the encryption parameters from the object metadata and unwraps the data key.
+func (c *Client) objectParams(metadata map[string]string, contentLength int64) (*objectParams, error) {
+	wrapped, ok := metadata[MetadataKey]
+	if !ok {
+		return nil, ErrNotEncrypted
+	}
+	if algorithm := metadata[MetadataAlgorithm]; algorithm != Algorithm {
+		return nil, fmt.Errorf("unsupported encryption algorithm %q", algorithm)
+	}
+	chunkSize, err := strconv.ParseInt(metadata[MetadataChunkSize], 10, 64)
+	if err != nil || chunkSize <= 0 || chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("invalid encryption chunk size %q", metadata[MetadataChunkSize])
+	}
+	nonce, err := base64.StdEncoding.DecodeString(metadata[MetadataNonce])
+	if err != nil || len(nonce) != nonceSize {
+		return nil, fmt.Errorf("invalid encryption nonce %q", metadata[MetadataNonce])
+	}
+	size, err := plaintextSize(contentLength, chunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.keys.DecryptDataKey(metadata[MetadataKeyID], wrapped)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &objectParams{aead: aead, nonce: nonce, chunkSize: chunkSize, size: size}, nil
+}
@@ -0,0 +1,36 @@
+/*
+Package encryption provides client-side envelope encryption of OBS objects with data keys
+generated by KMS.
+
+Every object is encrypted with a new AES-256 data key in chunks with AES-GCM. The data key
+wrapped by the KMS master key, the nonce and the chunk size are stored in the object metadata,
+so the object can be decrypted by any client having access to the master key. Single chunks
+are authenticated, so range reads decrypt only the chunks covering the range.
+
+Example of uploading and downloading an encrypted object
+
+	kmsClient, err := openstack.NewKMSV1(provider, golangsdk.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+	client := encryption.NewClient(obsClient, encryption.NewKMSKeyProvider(kmsClient, kmsKeyID))
+
+	putInput := &obs.PutObjectInput{}
+	putInput.Bucket = "bucket"
+	putInput.Key = "secret.txt"
+	putInput.Body = strings.NewReader("secret data")
+	_, err = client.PutObject(putInput)
+	if err != nil {
+		panic(err)
+	}
+
+	getInput := &obs.GetObjectInput{}
+	getInput.Bucket = "bucket"
+	getInput.Key = "secret.txt"
+	output, err := client.GetObject(getInput)
+	if err != nil {
+		panic(err)
+	}
+	defer output.Body.Close()
+*/
+package encryption
@@ -0,0 +1,74 @@
+package encryption
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+)
+
+// dataKeySize is the size of the AES-256 data keys in bytes.
+const dataKeySize = 32
+
+// DataKey is a data encryption key (DEK) together with its wrapped form.
+type DataKey struct {
+	// KeyID is the ID of the master key wrapping the DEK.
+	KeyID string
+	// Plaintext is the DEK used to encrypt the object. It is never stored.
+	Plaintext []byte
+	// Wrapped is the DEK encrypted with the master key, stored in the object metadata.
+	Wrapped string
+}
+
+// KeyProvider generates and unwraps data encryption keys.
+type KeyProvider interface {
+	// GenerateDataKey returns a new 32 bytes long DEK.
+	GenerateDataKey() (*DataKey, error)
+	// DecryptDataKey returns the plaintext of a DEK wrapped by the master key keyID.
+	DecryptDataKey(keyID, wrapped string) ([]byte, error)
+}
+
+type kmsKeyProvider struct {
+	client *golangsdk.ServiceClient
+	keyID  string
+}
+
+// NewKMSKeyProvider creates a KeyProvider generating data keys with the KMS master key keyID.
+func NewKMSKeyProvider(client *golangsdk.ServiceClient, keyID string) KeyProvider {
+	return &kmsKeyProvider{client: client, keyID: keyID}
+}
+
+func (p *kmsKeyProvider) GenerateDataKey() (*DataKey, error) {
+	dataKey, err := keys.DataEncryptGet(p.client, keys.DataEncryptOpts{
+		KeyID:         p.keyID,
+		DatakeyLength: fmt.Sprint(dataKeySize * 8),
+	}).ExtractDataKey()
+	if err != nil {
+		return nil, fmt.Errorf("error generating data key: %w", err)
+	}
+	plaintext, err := hex.DecodeString(dataKey.PlainText)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding data key: %w", err)
+	}
+	if len(plaintext) != dataKeySize {
+		return nil, fmt.Errorf("unexpected data key size: %d", len(plaintext))
+	}
+	return &DataKey{KeyID: dataKey.KeyID, Plaintext: plaintext, Wrapped: dataKey.CipherText}, nil
+}
+
+func (p *kmsKeyProvider) DecryptDataKey(keyID, wrapped string) ([]byte, error) {
+	dataKey, err := keys.DecryptDEK(p.client, keys.DecryptDEKOpts{
+		KeyID:               keyID,
+		CipherText:          wrapped,
+		DatakeyCipherLength: fmt.Sprint(dataKeySize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting data key: %w", err)
+	}
+	plaintext, err := hex.DecodeString(dataKey.DataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding data key: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,2 @@
+// encryption unit tests
+package testing
@@ -0,0 +1,211 @@
+package testing
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs/encryption"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs/obstest"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const bucketName = "test-bucket"
+
+// staticKeyProvider wraps data keys with a local master key.
+type staticKeyProvider struct {
+	masterKey []byte
+}
+
+func (p *staticKeyProvider) aead() cipher.AEAD {
+	block, _ := aes.NewCipher(p.masterKey)
+	aead, _ := cipher.NewGCM(block)
+	return aead
+}
+
+func (p *staticKeyProvider) GenerateDataKey() (*encryption.DataKey, error) {
+	key := make([]byte, 32)
+	nonce := make([]byte, 12)
+	_, _ = rand.Read(key)
+	_, _ = rand.Read(nonce)
+	wrapped := p.aead().Seal(nonce, nonce, key, nil)
+	return &encryption.DataKey{KeyID: "static", Plaintext: key, Wrapped: hex.EncodeToString(wrapped)}, nil
+}
+
+func (p *staticKeyProvider) DecryptDataKey(_, wrapped string) ([]byte, error) {
+	data, err := hex.DecodeString(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return p.aead().Open(nil, data[:12], data[12:], nil)
+}
+
+func setupClient(t *testing.T) (*obstest.Server, *encryption.Client) {
+	server := obstest.NewServer("test-ak", "test-sk")
+	t.Cleanup(server.Close)
+
+	obsClient, err := server.Client()
+	th.AssertNoErr(t, err)
+	t.Cleanup(obsClient.Close)
+	_, err = obsClient.CreateBucket(&obs.CreateBucketInput{Bucket: bucketName})
+	th.AssertNoErr(t, err)
+
+	client := encryption.NewClient(obsClient, &staticKeyProvider{masterKey: bytes.Repeat([]byte{7}, 32)})
+	client.ChunkSize = 1024
+	return server, client
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func putObject(t *testing.T, client *encryption.Client, key string, data []byte) {
+	input := &obs.PutObjectInput{}
+	input.Bucket = bucketName
+	input.Key = key
+	input.Metadata = map[string]string{"owner": "tester"}
+	input.Body = bytes.NewReader(data)
+	_, err := client.PutObject(input)
+	th.AssertNoErr(t, err)
+}
+
+func TestPutGetObject(t *testing.T) {
+	server, client := setupClient(t)
+
+	// plaintext size and the encrypted size with 16 bytes long tag per chunk
+	sizes := [][2]int{{0, 16}, {1, 17}, {1024, 1040}, {1025, 1057}, {5000, 5080}}
+	for _, s := range sizes {
+		size := s[0]
+		data := testData(size)
+		putObject(t, client, "object", data)
+
+		stored, _ := server.Object(bucketName, "object")
+		th.AssertEquals(t, s[1], len(stored))
+		// a short plaintext may appear in the ciphertext by chance
+		if size >= 16 {
+			th.AssertEquals(t, false, bytes.Contains(stored, data))
+		}
+
+		input := &obs.GetObjectInput{}
+		input.Bucket = bucketName
+		input.Key = "object"
+		output, err := client.GetObject(input)
+		th.AssertNoErr(t, err)
+		plaintext, err := io.ReadAll(output.Body)
+		th.AssertNoErr(t, err)
+		th.AssertNoErr(t, output.Body.Close())
+		th.AssertEquals(t, true, bytes.Equal(data, plaintext))
+		th.AssertEquals(t, int64(size), output.ContentLength)
+		th.AssertEquals(t, "tester", output.Metadata["owner"])
+	}
+}
+
+func TestGetObjectRange(t *testing.T) {
+	_, client := setupClient(t)
+
+	data := testData(5000)
+	putObject(t, client, "object", data)
+
+	ranges := [][2]int64{{0, 10}, {1000, 1100}, {1023, 1024}, {2048, 4999}, {4000, 9000}}
+	for _, r := range ranges {
+		input := &obs.GetObjectInput{}
+		input.Bucket = bucketName
+		input.Key = "object"
+		input.RangeStart = r[0]
+		input.RangeEnd = r[1]
+		output, err := client.GetObject(input)
+		th.AssertNoErr(t, err)
+		plaintext, err := io.ReadAll(output.Body)
+		th.AssertNoErr(t, err)
+		th.AssertNoErr(t, output.Body.Close())
+
+		end := r[1]
+		if end >= int64(len(data)) {
+			end = int64(len(data)) - 1
+		}
+		th.AssertEquals(t, true, bytes.Equal(data[r[0]:end+1], plaintext))
+		th.AssertEquals(t, end-r[0]+1, output.ContentLength)
+	}
+}
+
+func TestGetObjectTampered(t *testing.T) {
+	server, client := setupClient(t)
+
+	putObject(t, client, "object", testData(3000))
+
+	obsClient, err := server.Client()
+	th.AssertNoErr(t, err)
+	defer obsClient.Close()
+
+	// replace the content keeping the encryption metadata
+	stored, _ := server.Object(bucketName, "object")
+	metadata, _ := server.Metadata(bucketName, "object")
+	stored[2000] ^= 1
+	input := &obs.PutObjectInput{}
+	input.Bucket = bucketName
+	input.Key = "object"
+	input.Metadata = metadata
+	input.Body = bytes.NewReader(stored)
+	_, err = obsClient.PutObject(input)
+	th.AssertNoErr(t, err)
+
+	getInput := &obs.GetObjectInput{}
+	getInput.Bucket = bucketName
+	getInput.Key = "object"
+	output, err := client.GetObject(getInput)
+	th.AssertNoErr(t, err)
+	_, err = io.ReadAll(output.Body)
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = obsClient.PutObject(&obs.PutObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{ObjectOperationInput: obs.ObjectOperationInput{Bucket: bucketName, Key: "plain"}},
+		Body:                bytes.NewReader([]byte("plain")),
+	})
+	th.AssertNoErr(t, err)
+	getInput.Key = "plain"
+	_, err = client.GetObject(getInput)
+	th.AssertEquals(t, encryption.ErrNotEncrypted, err)
+}
+
+func TestPutFileDownloadFile(t *testing.T) {
+	_, client := setupClient(t)
+
+	dir := t.TempDir()
+	data := testData(300 * 1024)
+	source := filepath.Join(dir, "source.txt")
+	th.AssertNoErr(t, os.WriteFile(source, data, 0600))
+
+	putInput := &obs.PutFileInput{}
+	putInput.Bucket = bucketName
+	putInput.Key = "file"
+	putInput.SourceFile = source
+	_, err := client.PutFile(putInput)
+	th.AssertNoErr(t, err)
+
+	downloadInput := &obs.DownloadFileInput{}
+	downloadInput.Bucket = bucketName
+	downloadInput.Key = "file"
+	downloadInput.DownloadFile = filepath.Join(dir, "target.txt")
+	downloadInput.PartSize = obs.MIN_PART_SIZE
+	downloadInput.TaskNum = 2
+	output, err := client.DownloadFile(downloadInput)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(data)), output.ContentLength)
+
+	downloaded, err := os.ReadFile(downloadInput.DownloadFile)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, bytes.Equal(data, downloaded))
+	_, err = os.Stat(downloadInput.DownloadFile + ".encrypted")
+	th.AssertEquals(t, true, os.IsNotExist(err))
+}
@@ -2,6 +2,7 @@ package obs
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -163,9 +164,7 @@ func (obsClient ObsClient) doHttpWithSignedUrl(action, method string, signedUrl
 	if err != nil {
 		return err
 	}
-	if obsClient.conf.ctx != nil {
-		req = req.WithContext(obsClient.conf.ctx)
-	}
+	req = req.WithContext(obsClient.context())
 	var resp *http.Response
 
 	doLog(LEVEL_INFO, "Do %s with signedUrl %s...", action, signedUrl)
@@ -283,9 +282,7 @@ func (obsClient ObsClient) doHttp(method, bucketName, objectKey string, params m
 		if err != nil {
 			return nil, fmt.Errorf("failed to build a request: %s", err)
 		}
-		if obsClient.conf.ctx != nil {
-			req = req.WithContext(obsClient.conf.ctx)
-		}
+		req = req.WithContext(obsClient.context())
 		doLog(LEVEL_DEBUG, "Do request with url [%s] and method [%s]", requestUrl, method)
 
 		if isDebugLogEnabled() {
@@ -327,7 +324,7 @@ func (obsClient ObsClient) doHttp(method, bucketName, objectKey string, params m
 			msg = err
 			respError = err
 			resp = nil
-			if !repeatable {
+			if !repeatable || req.Context().Err() != nil {
 				break
 			}
 		} else {
@@ -385,10 +382,12 @@ func (obsClient ObsClient) doHttp(method, bucketName, objectKey string, params m
 					return nil, err
 				}
 				defer fd.Close()
+				r.rewindProgress()
 				fileReaderWrapper := &fileReaderWrapper{filePath: r.filePath}
 				fileReaderWrapper.mark = r.mark
 				fileReaderWrapper.reader = fd
 				fileReaderWrapper.totalCount = r.totalCount
+				fileReaderWrapper.tracker = r.tracker
 				_data = fileReaderWrapper
 				_, err = fd.Seek(r.mark, 0)
 				if err != nil {
@@ -399,8 +398,11 @@ func (obsClient ObsClient) doHttp(method, bucketName, objectKey string, params m
 				if err != nil {
 					return nil, err
 				}
+				r.rewindProgress()
+			}
+			if err := sleepWithContext(obsClient.context(), time.Duration(float64(i+2)*rand.Float64()*float64(time.Second))); err != nil {
+				return nil, err
 			}
-			time.Sleep(time.Duration(float64(i+2) * rand.Float64() * float64(time.Second)))
 		} else {
 			doLog(LEVEL_ERROR, "Failed to send request with reason:%v", msg)
 			if resp != nil {
@@ -412,6 +414,18 @@ func (obsClient ObsClient) doHttp(method, bucketName, objectKey string, params m
 	return
 }
 
+// sleepWithContext pauses for d, returning early with the context error once ctx is done.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 type connDelegate struct {
 	conn          net.Conn
 	socketTimeout time.Duration
@@ -0,0 +1,354 @@
+package obs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const maxDeleteObjectsCount = 1000
+
+var errMarkerNotAdvanced = errors.New("listing marker did not advance")
+
+// ObjectIterator walks all objects of a bucket matching a prefix, fetching the pages lazily.
+//
+//	it := obsClient.NewObjectIterator(input)
+//	for it.Next() {
+//		if object := it.Object(); object != nil {
+//			fmt.Println(object.Key)
+//		}
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+//
+// With Delimiter set, keys sharing a common prefix are grouped: every group is returned once
+// with a nil Object and the prefix in CommonPrefix. On every page the objects are returned
+// before the common prefixes.
+type ObjectIterator struct {
+	obsClient ObsClient
+	input     ListObjectsInput
+	output    *ListObjectsOutput
+	index     int
+	done      bool
+	err       error
+	object    *Content
+	prefix    string
+}
+
+// NewObjectIterator creates an ObjectIterator starting at input.Marker. The input is not modified.
+func (obsClient ObsClient) NewObjectIterator(input *ListObjectsInput) *ObjectIterator {
+	it := &ObjectIterator{obsClient: obsClient}
+	if input != nil {
+		it.input = *input
+	}
+	return it
+}
+
+// Next advances the iterator to the next object or common prefix. It returns false when the
+// listing is exhausted or an error occurred.
+func (it *ObjectIterator) Next() bool {
+	it.object, it.prefix = nil, ""
+	for it.err == nil {
+		if it.output != nil {
+			contents, prefixes := it.output.Contents, it.output.CommonPrefixes
+			if it.index < len(contents) {
+				it.object = &contents[it.index]
+				it.index++
+				return true
+			}
+			if i := it.index - len(contents); i < len(prefixes) {
+				it.prefix = prefixes[i]
+				it.index++
+				return true
+			}
+			if !it.output.IsTruncated {
+				it.done = true
+			}
+		}
+		if it.done {
+			return false
+		}
+		if it.output != nil {
+			marker := nextListObjectsMarker(it.output)
+			if marker == "" || marker == it.input.Marker {
+				it.err = errMarkerNotAdvanced
+				return false
+			}
+			it.input.Marker = marker
+		}
+		it.output, it.err = it.obsClient.ListObjects(&it.input)
+		it.index = 0
+	}
+	return false
+}
+
+// Object returns the current object, or nil if the current item is a common prefix.
+func (it *ObjectIterator) Object() *Content {
+	return it.object
+}
+
+// CommonPrefix returns the current common prefix, or an empty string if the current item is an object.
+func (it *ObjectIterator) CommonPrefix() string {
+	return it.prefix
+}
+
+// Err returns the error which stopped the iteration.
+func (it *ObjectIterator) Err() error {
+	return it.err
+}
+
+func nextListObjectsMarker(output *ListObjectsOutput) string {
+	if output.NextMarker != "" {
+		return output.NextMarker
+	}
+	// NextMarker is only returned when Delimiter is set
+	marker := ""
+	if len(output.Contents) > 0 {
+		marker = output.Contents[len(output.Contents)-1].Key
+	}
+	if len(output.CommonPrefixes) > 0 {
+		if prefix := output.CommonPrefixes[len(output.CommonPrefixes)-1]; prefix > marker {
+			marker = prefix
+		}
+	}
+	return marker
+}
+
+// VersionIterator walks all object versions and delete markers of a bucket matching a prefix,
+// fetching the pages lazily. It is used the same way as ObjectIterator.
+//
+// On every page the versions are returned first, then the delete markers and the common prefixes.
+type VersionIterator struct {
+	obsClient    ObsClient
+	input        ListVersionsInput
+	output       *ListVersionsOutput
+	index        int
+	done         bool
+	err          error
+	version      *Version
+	deleteMarker *DeleteMarker
+	prefix       string
+}
+
+// NewVersionIterator creates a VersionIterator starting at input.KeyMarker and input.VersionIdMarker.
+// The input is not modified.
+func (obsClient ObsClient) NewVersionIterator(input *ListVersionsInput) *VersionIterator {
+	it := &VersionIterator{obsClient: obsClient}
+	if input != nil {
+		it.input = *input
+	}
+	return it
+}
+
+// Next advances the iterator to the next version, delete marker or common prefix. It returns false
+// when the listing is exhausted or an error occurred.
+func (it *VersionIterator) Next() bool {
+	it.version, it.deleteMarker, it.prefix = nil, nil, ""
+	for it.err == nil {
+		if it.output != nil {
+			versions, markers, prefixes := it.output.Versions, it.output.DeleteMarkers, it.output.CommonPrefixes
+			i := it.index
+			if i < len(versions) {
+				it.version = &versions[i]
+				it.index++
+				return true
+			}
+			if i -= len(versions); i < len(markers) {
+				it.deleteMarker = &markers[i]
+				it.index++
+				return true
+			}
+			if i -= len(markers); i < len(prefixes) {
+				it.prefix = prefixes[i]
+				it.index++
+				return true
+			}
+			if !it.output.IsTruncated {
+				it.done = true
+			}
+		}
+		if it.done {
+			return false
+		}
+		if it.output != nil {
+			keyMarker, versionIdMarker := it.output.NextKeyMarker, it.output.NextVersionIdMarker
+			if keyMarker == it.input.KeyMarker && versionIdMarker == it.input.VersionIdMarker {
+				it.err = errMarkerNotAdvanced
+				return false
+			}
+			it.input.KeyMarker, it.input.VersionIdMarker = keyMarker, versionIdMarker
+		}
+		it.output, it.err = it.obsClient.ListVersions(&it.input)
+		it.index = 0
+	}
+	return false
+}
+
+// Version returns the current version, or nil if the current item is not a version.
+func (it *VersionIterator) Version() *Version {
+	return it.version
+}
+
+// DeleteMarker returns the current delete marker, or nil if the current item is not a delete marker.
+func (it *VersionIterator) DeleteMarker() *DeleteMarker {
+	return it.deleteMarker
+}
+
+// CommonPrefix returns the current common prefix, or an empty string if the current item is not a prefix.
+func (it *VersionIterator) CommonPrefix() string {
+	return it.prefix
+}
+
+// Err returns the error which stopped the iteration.
+func (it *VersionIterator) Err() error {
+	return it.err
+}
+
+// MultipartUploadIterator walks all multipart uploads of a bucket matching a prefix, fetching
+// the pages lazily. It is used the same way as ObjectIterator.
+//
+// On every page the uploads are returned before the common prefixes.
+type MultipartUploadIterator struct {
+	obsClient ObsClient
+	input     ListMultipartUploadsInput
+	output    *ListMultipartUploadsOutput
+	index     int
+	done      bool
+	err       error
+	upload    *Upload
+	prefix    string
+}
+
+// NewMultipartUploadIterator creates a MultipartUploadIterator starting at input.KeyMarker and
+// input.UploadIdMarker. The input is not modified.
+func (obsClient ObsClient) NewMultipartUploadIterator(input *ListMultipartUploadsInput) *MultipartUploadIterator {
+	it := &MultipartUploadIterator{obsClient: obsClient}
+	if input != nil {
+		it.input = *input
+	}
+	return it
+}
+
+// Next advances the iterator to the next upload or common prefix. It returns false when the
+// listing is exhausted or an error occurred.
+func (it *MultipartUploadIterator) Next() bool {
+	it.upload, it.prefix = nil, ""
+	for it.err == nil {
+		if it.output != nil {
+			uploads, prefixes := it.output.Uploads, it.output.CommonPrefixes
+			if it.index < len(uploads) {
+				it.upload = &uploads[it.index]
+				it.index++
+				return true
+			}
+			if i := it.index - len(uploads); i < len(prefixes) {
+				it.prefix = prefixes[i]
+				it.index++
+				return true
+			}
+			if !it.output.IsTruncated {
+				it.done = true
+			}
+		}
+		if it.done {
+			return false
+		}
+		if it.output != nil {
+			keyMarker, uploadIdMarker := it.output.NextKeyMarker, it.output.NextUploadIdMarker
+			if keyMarker == it.input.KeyMarker && uploadIdMarker == it.input.UploadIdMarker {
+				it.err = errMarkerNotAdvanced
+				return false
+			}
+			it.input.KeyMarker, it.input.UploadIdMarker = keyMarker, uploadIdMarker
+		}
+		it.output, it.err = it.obsClient.ListMultipartUploads(&it.input)
+		it.index = 0
+	}
+	return false
+}
+
+// Upload returns the current upload, or nil if the current item is a common prefix.
+func (it *MultipartUploadIterator) Upload() *Upload {
+	return it.upload
+}
+
+// CommonPrefix returns the current common prefix, or an empty string if the current item is an upload.
+func (it *MultipartUploadIterator) CommonPrefix() string {
+	return it.prefix
+}
+
+// Err returns the error which stopped the iteration.
+func (it *MultipartUploadIterator) Err() error {
+	return it.err
+}
+
+// EmptyBucket deletes all objects, object versions and delete markers of a bucket and aborts its
+// multipart uploads, so that the bucket can be deleted with DeleteBucket.
+//
+// Objects are deleted with DeleteObjects in batches of 1000 keys. Objects protected by WORM
+// retention can't be deleted and are reported in the returned error.
+func (obsClient ObsClient) EmptyBucket(bucketName string) error {
+	uploads := obsClient.NewMultipartUploadIterator(&ListMultipartUploadsInput{Bucket: bucketName})
+	for uploads.Next() {
+		upload := uploads.Upload()
+		_, err := obsClient.AbortMultipartUpload(&AbortMultipartUploadInput{
+			Bucket:   bucketName,
+			Key:      upload.Key,
+			UploadId: upload.UploadId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := uploads.Err(); err != nil {
+		return err
+	}
+
+	var failed []string
+	batch := make([]ObjectToDelete, 0, maxDeleteObjectsCount)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		output, err := obsClient.DeleteObjects(&DeleteObjectsInput{
+			Bucket:  bucketName,
+			Quiet:   true,
+			Objects: batch,
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range output.Errors {
+			failed = append(failed, fmt.Sprintf("%s (%s): %s", e.Key, e.VersionId, e.Message))
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	versions := obsClient.NewVersionIterator(&ListVersionsInput{Bucket: bucketName})
+	for versions.Next() {
+		switch {
+		case versions.Version() != nil:
+			batch = append(batch, ObjectToDelete{Key: versions.Version().Key, VersionId: versions.Version().VersionId})
+		case versions.DeleteMarker() != nil:
+			batch = append(batch, ObjectToDelete{Key: versions.DeleteMarker().Key, VersionId: versions.DeleteMarker().VersionId})
+		}
+		if len(batch) == maxDeleteObjectsCount {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := versions.Err(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete %d objects of bucket %s: %s", len(failed), bucketName, strings.Join(failed, "; "))
+	}
+	return nil
+}
@@ -342,6 +342,14 @@ type ReplicationRule struct {
 	HistoricalObjectReplication EnabledType      `xml:"HistoricalObjectReplication,omitempty"`
 }
 
+// ObjectRetention defines the WORM retention of an object
+type ObjectRetention struct {
+	XMLName xml.Name `xml:"Retention"`
+	Mode    string   `xml:"Mode"`
+	// RetainUntilDate is a timestamp in milliseconds
+	RetainUntilDate int64 `xml:"RetainUntilDate"`
+}
+
 // BucketWormPolicy defines bucket WORM policy rule
 type BucketWormPolicy struct {
 	XMLName           xml.Name `xml:"ObjectLockConfiguration"`
@@ -177,6 +177,10 @@ type GetObjectInput struct {
 	ResponseContentLanguage    string
 	ResponseContentType        string
 	ResponseExpires            string
+	ProgressListener           ProgressListener
+	RateLimiter                *RateLimiter
+
+	tracker *progressTracker
 }
 
 // GetObjectOutput is the result of GetObject function
@@ -218,13 +222,17 @@ type PutObjectBasicInput struct {
 // PutObjectInput is the input parameter of PutObject function
 type PutObjectInput struct {
 	PutObjectBasicInput
-	Body io.Reader
+	Body             io.Reader
+	ProgressListener ProgressListener
+	RateLimiter      *RateLimiter
 }
 
 // PutFileInput is the input parameter of PutFile function
 type PutFileInput struct {
 	PutObjectBasicInput
-	SourceFile string
+	SourceFile       string
+	ProgressListener ProgressListener
+	RateLimiter      *RateLimiter
 }
 
 // PutObjectOutput is the result of PutObject function
@@ -278,6 +286,8 @@ type UploadFileInput struct {
 	EnableCheckpoint bool
 	CheckpointFile   string
 	EncodingType     string
+	ProgressListener ProgressListener
+	RateLimiter      *RateLimiter
 }
 
 // DownloadFileInput is the input parameter of DownloadFile function
@@ -292,6 +302,8 @@ type DownloadFileInput struct {
 	TaskNum           int
 	EnableCheckpoint  bool
 	CheckpointFile    string
+	ProgressListener  ProgressListener
+	RateLimiter       *RateLimiter
 }
 
 // HeadObjectInput is the input parameter of HeadObject function
@@ -300,3 +312,70 @@ type HeadObjectInput struct {
 	Key       string
 	VersionId string
 }
+
+// SetObjectTaggingInput is the input parameter of SetObjectTagging function
+type SetObjectTaggingInput struct {
+	Bucket    string `xml:"-"`
+	Key       string `xml:"-"`
+	VersionId string `xml:"-"`
+	BucketTagging
+}
+
+// GetObjectTaggingInput is the input parameter of GetObjectTagging function
+type GetObjectTaggingInput struct {
+	Bucket    string
+	Key       string
+	VersionId string
+}
+
+// GetObjectTaggingOutput is the result of GetObjectTagging function
+type GetObjectTaggingOutput struct {
+	BaseModel
+	VersionId string
+	BucketTagging
+}
+
+// DeleteObjectTaggingInput is the input parameter of DeleteObjectTagging function
+type DeleteObjectTaggingInput struct {
+	Bucket    string
+	Key       string
+	VersionId string
+}
+
+// AppendObjectInput is the input parameter of AppendObject function
+type AppendObjectInput struct {
+	PutObjectBasicInput
+	Body     io.Reader
+	Position int64
+}
+
+// AppendObjectOutput is the result of AppendObject function
+type AppendObjectOutput struct {
+	BaseModel
+	VersionId          string
+	SseHeader          ISseHeader
+	ETag               string
+	NextAppendPosition int64
+}
+
+// SetObjectRetentionInput is the input parameter of SetObjectRetention function
+type SetObjectRetentionInput struct {
+	Bucket    string `xml:"-"`
+	Key       string `xml:"-"`
+	VersionId string `xml:"-"`
+	ObjectRetention
+}
+
+// GetObjectRetentionInput is the input parameter of GetObjectRetention function
+type GetObjectRetentionInput struct {
+	Bucket    string
+	Key       string
+	VersionId string
+}
+
+// GetObjectRetentionOutput is the result of GetObjectRetention function
+type GetObjectRetentionOutput struct {
+	BaseModel
+	VersionId string
+	ObjectRetention
+}
@@ -0,0 +1,20 @@
+/*
+Package obstest provides an in-memory OBS compatible server, so that code using the obs package
+can be tested without access to the cloud.
+
+The server supports bucket creation, listing and deletion, object upload, download with ranges,
+metadata and deletion, object listing with prefix and delimiter, batch deletion and multipart
+uploads. Requests are authenticated with the same signers the obs client uses.
+
+Example of using the server
+
+	server := obstest.NewServer("ak", "sk")
+	defer server.Close()
+
+	client, err := server.Client()
+	if err != nil {
+		panic(err)
+	}
+	_, err = client.CreateBucket(&obs.CreateBucketInput{Bucket: "test-bucket"})
+*/
+package obstest
@@ -0,0 +1,155 @@
+package obstest
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type owner struct {
+	ID string `xml:"ID"`
+}
+
+type bucketEntry struct {
+	Name         string    `xml:"Name"`
+	CreationDate time.Time `xml:"CreationDate"`
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name      `xml:"ListAllMyBucketsResult"`
+	Owner   owner         `xml:"Owner"`
+	Buckets []bucketEntry `xml:"Buckets>Bucket"`
+}
+
+type contentEntry struct {
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+	Owner        owner     `xml:"Owner"`
+	StorageClass string    `xml:"StorageClass"`
+}
+
+type commonPrefixEntry struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name            `xml:"ListBucketResult"`
+	Name           string              `xml:"Name"`
+	Prefix         string              `xml:"Prefix"`
+	Marker         string              `xml:"Marker"`
+	NextMarker     string              `xml:"NextMarker,omitempty"`
+	MaxKeys        int                 `xml:"MaxKeys"`
+	Delimiter      string              `xml:"Delimiter,omitempty"`
+	IsTruncated    bool                `xml:"IsTruncated"`
+	Contents       []contentEntry      `xml:"Contents"`
+	CommonPrefixes []commonPrefixEntry `xml:"CommonPrefixes"`
+}
+
+type versionEntry struct {
+	Key          string    `xml:"Key"`
+	VersionId    string    `xml:"VersionId"`
+	IsLatest     bool      `xml:"IsLatest"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+	Owner        owner     `xml:"Owner"`
+	StorageClass string    `xml:"StorageClass"`
+}
+
+type listVersionsResult struct {
+	XMLName             xml.Name       `xml:"ListVersionsResult"`
+	Name                string         `xml:"Name"`
+	Prefix              string         `xml:"Prefix"`
+	KeyMarker           string         `xml:"KeyMarker"`
+	VersionIdMarker     string         `xml:"VersionIdMarker"`
+	NextKeyMarker       string         `xml:"NextKeyMarker,omitempty"`
+	NextVersionIdMarker string         `xml:"NextVersionIdMarker,omitempty"`
+	MaxKeys             int            `xml:"MaxKeys"`
+	IsTruncated         bool           `xml:"IsTruncated"`
+	Versions            []versionEntry `xml:"Version"`
+}
+
+type uploadEntry struct {
+	Key          string    `xml:"Key"`
+	UploadId     string    `xml:"UploadId"`
+	Initiated    time.Time `xml:"Initiated"`
+	StorageClass string    `xml:"StorageClass"`
+	Owner        owner     `xml:"Owner"`
+	Initiator    owner     `xml:"Initiator"`
+}
+
+type listMultipartUploadsResult struct {
+	XMLName            xml.Name      `xml:"ListMultipartUploadsResult"`
+	Bucket             string        `xml:"Bucket"`
+	Prefix             string        `xml:"Prefix"`
+	KeyMarker          string        `xml:"KeyMarker"`
+	UploadIdMarker     string        `xml:"UploadIdMarker"`
+	NextKeyMarker      string        `xml:"NextKeyMarker,omitempty"`
+	NextUploadIdMarker string        `xml:"NextUploadIdMarker,omitempty"`
+	MaxUploads         int           `xml:"MaxUploads"`
+	IsTruncated        bool          `xml:"IsTruncated"`
+	Uploads            []uploadEntry `xml:"Upload"`
+}
+
+type objectToDelete struct {
+	Key       string `xml:"Key"`
+	VersionId string `xml:"VersionId"`
+}
+
+type deleteRequest struct {
+	XMLName xml.Name         `xml:"Delete"`
+	Quiet   bool             `xml:"Quiet"`
+	Objects []objectToDelete `xml:"Object"`
+}
+
+type deletedEntry struct {
+	Key       string `xml:"Key"`
+	VersionId string `xml:"VersionId,omitempty"`
+}
+
+type deleteError struct {
+	Key       string `xml:"Key"`
+	VersionId string `xml:"VersionId,omitempty"`
+	Code      string `xml:"Code"`
+	Message   string `xml:"Message"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name       `xml:"DeleteResult"`
+	Deleted []deletedEntry `xml:"Deleted"`
+	Errors  []deleteError  `xml:"Error"`
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadId string   `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestId string   `xml:"RequestId"`
+	HostId    string   `xml:"HostId"`
+}
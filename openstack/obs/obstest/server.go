@@ -0,0 +1,761 @@
+package obstest
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+)
+
+const (
+	defaultMaxKeys = 1000
+	nullVersionId  = "null"
+	metaPrefixObs  = "x-obs-meta-"
+	metaPrefixAmz  = "x-amz-meta-"
+)
+
+type object struct {
+	data         []byte
+	contentType  string
+	metadata     map[string]string
+	etag         string
+	lastModified time.Time
+}
+
+type bucket struct {
+	created time.Time
+	objects map[string]*object
+}
+
+type multipartUpload struct {
+	bucket      string
+	key         string
+	contentType string
+	metadata    map[string]string
+	initiated   time.Time
+	parts       map[int]*object
+}
+
+// Server is an in-memory OBS compatible server for tests.
+//
+// Requests must use path-style addressing and are authenticated against the server
+// credentials with the signers of the obs package, so V2, V4 and OBS signatures are accepted.
+type Server struct {
+	*httptest.Server
+
+	AccessKey string
+	SecretKey string
+
+	// Intercept is called before every request is handled. If it returns true, the request is
+	// considered answered, which allows tests to inject failures.
+	Intercept func(w http.ResponseWriter, r *http.Request) bool
+
+	lock     sync.Mutex
+	buckets  map[string]*bucket
+	uploads  map[string]*multipartUpload
+	uploadID int
+}
+
+// NewServer starts a new Server accepting requests signed with the given credentials.
+// The caller should call Close when finished, to shut it down.
+func NewServer(ak, sk string) *Server {
+	s := &Server{
+		AccessKey: ak,
+		SecretKey: sk,
+		buckets:   make(map[string]*bucket),
+		uploads:   make(map[string]*multipartUpload),
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Client creates an ObsClient talking to the server. The configurers are applied after the
+// ones required by the server.
+func (s *Server) Client(configurers ...obs.Configurer) (*obs.ObsClient, error) {
+	configurers = append([]obs.Configurer{obs.WithPathStyle(true), obs.WithMaxRetryCount(0)}, configurers...)
+	return obs.New(s.AccessKey, s.SecretKey, s.URL, configurers...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.Intercept != nil && s.Intercept(w, r) {
+		return
+	}
+
+	escapedPath := strings.TrimPrefix(r.URL.EscapedPath(), "/")
+	bucketName, escapedKey := escapedPath, ""
+	if i := strings.Index(escapedPath, "/"); i >= 0 {
+		bucketName, escapedKey = escapedPath[:i], escapedPath[i+1:]
+	}
+	key, err := url.PathUnescape(escapedKey)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidURI", err.Error())
+		return
+	}
+
+	if code, msg := s.authenticate(r, bucketName, escapedKey); code != "" {
+		writeError(w, http.StatusForbidden, code, msg)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case bucketName == "" && r.Method == http.MethodGet:
+		s.listBuckets(w)
+	case bucketName == "":
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed")
+	case key == "":
+		s.serveBucket(w, r, bucketName)
+	default:
+		s.serveObject(w, r, bucketName, key)
+	}
+}
+
+// authenticate validates the request signature and returns the error code on failure.
+func (s *Server) authenticate(r *http.Request, bucketName, escapedKey string) (code string, msg string) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return "AccessDenied", "Anonymous access is forbidden"
+	}
+
+	headers := make(map[string][]string, len(r.Header)+1)
+	for k, v := range r.Header {
+		headers[strings.ToLower(k)] = v
+	}
+	headers["host"] = []string{r.Host}
+
+	var ak, signature string
+	if strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 ") {
+		for _, part := range strings.Split(strings.TrimPrefix(authorization, "AWS4-HMAC-SHA256 "), ",") {
+			part = strings.TrimSpace(part)
+			switch {
+			case strings.HasPrefix(part, "Credential="):
+				ak = strings.SplitN(strings.TrimPrefix(part, "Credential="), "/", 2)[0]
+			case strings.HasPrefix(part, "Signature="):
+				signature = strings.TrimPrefix(part, "Signature=")
+			}
+		}
+	} else if fields := strings.SplitN(authorization, " ", 2); len(fields) == 2 {
+		if i := strings.LastIndex(fields[1], ":"); i > 0 {
+			ak, signature = fields[1][:i], fields[1][i+1:]
+		}
+	}
+	if ak != s.AccessKey {
+		return "InvalidAccessKeyId", "The access key Id you provided does not exist in our records"
+	}
+
+	expected := obs.GetAuthorization(s.AccessKey, s.SecretKey, r.Method, bucketName, escapedKey, r.URL.RawQuery, headers)
+	if expected == nil || expected["Signature"] != signature {
+		return "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided"
+	}
+	return "", ""
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter) {
+	result := listAllMyBucketsResult{Owner: owner{ID: s.AccessKey}}
+	for _, name := range sortedBucketNames(s.buckets) {
+		result.Buckets = append(result.Buckets, bucketEntry{Name: name, CreationDate: s.buckets[name].created})
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *Server) serveBucket(w http.ResponseWriter, r *http.Request, bucketName string) {
+	b, exists := s.buckets[bucketName]
+	if r.Method == http.MethodPut {
+		if len(r.URL.Query()) > 0 {
+			writeError(w, http.StatusNotImplemented, "NotImplemented", "Bucket configuration is not supported")
+			return
+		}
+		if exists {
+			writeError(w, http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it")
+			return
+		}
+		s.buckets[bucketName] = &bucket{created: time.Now().UTC(), objects: make(map[string]*object)}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		if len(b.objects) > 0 || s.hasUploads(bucketName) {
+			writeError(w, http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+			return
+		}
+		delete(s.buckets, bucketName)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		query := r.URL.Query()
+		switch {
+		case query.Has("versions"):
+			s.listVersions(w, r, bucketName, b)
+		case query.Has("uploads"):
+			s.listUploads(w, r, bucketName)
+		case isListObjectsQuery(query):
+			s.listObjects(w, r, bucketName, b)
+		default:
+			writeError(w, http.StatusNotImplemented, "NotImplemented", "Bucket configuration is not supported")
+		}
+	case http.MethodPost:
+		if !r.URL.Query().Has("delete") {
+			writeError(w, http.StatusNotImplemented, "NotImplemented", "The specified method is not supported")
+			return
+		}
+		s.deleteObjects(w, r, b)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "The specified method is not supported")
+	}
+}
+
+func isListObjectsQuery(query url.Values) bool {
+	for k := range query {
+		switch k {
+		case "prefix", "delimiter", "marker", "max-keys":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, bucketName string, b *bucket) {
+	query := r.URL.Query()
+	prefix, delimiter, marker := query.Get("prefix"), query.Get("delimiter"), query.Get("marker")
+	maxKeys := defaultMaxKeys
+	if v := query.Get("max-keys"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", "Invalid max-keys")
+			return
+		}
+		if n < maxKeys {
+			maxKeys = n
+		}
+	}
+
+	result := listBucketResult{
+		Name:      bucketName,
+		Prefix:    prefix,
+		Marker:    marker,
+		Delimiter: delimiter,
+		MaxKeys:   maxKeys,
+	}
+	seenPrefixes := make(map[string]bool)
+	count := 0
+	last := ""
+	for _, key := range sortedObjectKeys(b.objects) {
+		if !strings.HasPrefix(key, prefix) || key <= marker {
+			continue
+		}
+		commonPrefix := ""
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		if commonPrefix != "" && seenPrefixes[commonPrefix] {
+			continue
+		}
+		if commonPrefix != "" && commonPrefix <= marker {
+			// the group was returned on a previous page
+			continue
+		}
+		if count == maxKeys {
+			result.IsTruncated = true
+			result.NextMarker = last
+			break
+		}
+		count++
+		if commonPrefix != "" {
+			seenPrefixes[commonPrefix] = true
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefixEntry{Prefix: commonPrefix})
+			last = commonPrefix
+			continue
+		}
+		o := b.objects[key]
+		result.Contents = append(result.Contents, contentEntry{
+			Key:          key,
+			LastModified: o.lastModified,
+			ETag:         quote(o.etag),
+			Size:         int64(len(o.data)),
+			StorageClass: "STANDARD",
+			Owner:        owner{ID: s.AccessKey},
+		})
+		last = key
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+// listVersions lists the objects as versions of an unversioned bucket.
+func (s *Server) listVersions(w http.ResponseWriter, r *http.Request, bucketName string, b *bucket) {
+	query := r.URL.Query()
+	prefix, keyMarker := query.Get("prefix"), query.Get("key-marker")
+	maxKeys := defaultMaxKeys
+	if n, err := strconv.Atoi(query.Get("max-keys")); err == nil && n >= 0 && n < maxKeys {
+		maxKeys = n
+	}
+
+	result := listVersionsResult{
+		Name:            bucketName,
+		Prefix:          prefix,
+		KeyMarker:       keyMarker,
+		VersionIdMarker: query.Get("version-id-marker"),
+		MaxKeys:         maxKeys,
+	}
+	for _, key := range sortedObjectKeys(b.objects) {
+		if !strings.HasPrefix(key, prefix) || key <= keyMarker {
+			continue
+		}
+		if len(result.Versions) == maxKeys {
+			result.IsTruncated = true
+			result.NextKeyMarker = result.Versions[len(result.Versions)-1].Key
+			result.NextVersionIdMarker = nullVersionId
+			break
+		}
+		o := b.objects[key]
+		result.Versions = append(result.Versions, versionEntry{
+			Key:          key,
+			VersionId:    nullVersionId,
+			IsLatest:     true,
+			LastModified: o.lastModified,
+			ETag:         quote(o.etag),
+			Size:         int64(len(o.data)),
+			Owner:        owner{ID: s.AccessKey},
+			StorageClass: "STANDARD",
+		})
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+// listUploads lists the uploads ordered by key and upload ID.
+func (s *Server) listUploads(w http.ResponseWriter, r *http.Request, bucketName string) {
+	query := r.URL.Query()
+	prefix, keyMarker, uploadIdMarker := query.Get("prefix"), query.Get("key-marker"), query.Get("upload-id-marker")
+	maxUploads := defaultMaxKeys
+	if n, err := strconv.Atoi(query.Get("max-uploads")); err == nil && n >= 0 && n < maxUploads {
+		maxUploads = n
+	}
+
+	result := listMultipartUploadsResult{
+		Bucket:         bucketName,
+		Prefix:         prefix,
+		KeyMarker:      keyMarker,
+		UploadIdMarker: uploadIdMarker,
+		MaxUploads:     maxUploads,
+	}
+	ids := sortedUploadIDs(s.uploads)
+	sort.SliceStable(ids, func(i, j int) bool {
+		return s.uploads[ids[i]].key < s.uploads[ids[j]].key
+	})
+	for _, uploadID := range ids {
+		upload := s.uploads[uploadID]
+		if upload.bucket != bucketName || !strings.HasPrefix(upload.key, prefix) {
+			continue
+		}
+		if upload.key < keyMarker || upload.key == keyMarker && (uploadIdMarker == "" || uploadID <= uploadIdMarker) {
+			continue
+		}
+		if len(result.Uploads) == maxUploads {
+			last := result.Uploads[len(result.Uploads)-1]
+			result.IsTruncated = true
+			result.NextKeyMarker = last.Key
+			result.NextUploadIdMarker = last.UploadId
+			break
+		}
+		result.Uploads = append(result.Uploads, uploadEntry{
+			Key:          upload.key,
+			UploadId:     uploadID,
+			Initiated:    upload.initiated,
+			StorageClass: "STANDARD",
+			Owner:        owner{ID: s.AccessKey},
+			Initiator:    owner{ID: s.AccessKey},
+		})
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *Server) deleteObjects(w http.ResponseWriter, r *http.Request, b *bucket) {
+	var request deleteRequest
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed")
+		return
+	}
+	result := deleteResult{}
+	for _, o := range request.Objects {
+		if o.VersionId != "" && o.VersionId != nullVersionId {
+			result.Errors = append(result.Errors, deleteError{Key: o.Key, VersionId: o.VersionId, Code: "NoSuchVersion", Message: "The specified version does not exist"})
+			continue
+		}
+		delete(b.objects, o.Key)
+		if !request.Quiet {
+			result.Deleted = append(result.Deleted, deletedEntry{Key: o.Key, VersionId: o.VersionId})
+		}
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, bucketName, key string) {
+	b, ok := s.buckets[bucketName]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.initiateUpload(w, r, bucketName, key)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		s.uploadPart(w, r, bucketName, key)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		s.completeUpload(w, r, b, bucketName, key)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		if _, err := s.getUpload(query.Get("uploadId"), bucketName, key); err != nil {
+			writeError(w, http.StatusNotFound, "NoSuchUpload", err.Error())
+			return
+		}
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case len(query) > 0 && r.Method != http.MethodGet && r.Method != http.MethodHead:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "Object configuration is not supported")
+	case r.Method == http.MethodPut:
+		if r.Header.Get("X-Obs-Copy-Source") != "" || r.Header.Get("X-Amz-Copy-Source") != "" {
+			writeError(w, http.StatusNotImplemented, "NotImplemented", "Copying objects is not supported")
+			return
+		}
+		o, err := readObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "BadDigest", err.Error())
+			return
+		}
+		b.objects[key] = o
+		w.Header().Set("ETag", quote(o.etag))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		o, ok := b.objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist")
+			return
+		}
+		s.writeObject(w, r, o)
+	case r.Method == http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "The specified method is not supported")
+	}
+}
+
+func (s *Server) writeObject(w http.ResponseWriter, r *http.Request, o *object) {
+	if match := r.Header.Get("If-Match"); match != "" && strings.Trim(match, "\"") != o.etag {
+		writeError(w, http.StatusPreconditionFailed, "PreconditionFailed", "At least one of the preconditions you specified did not hold")
+		return
+	}
+
+	header := w.Header()
+	for k, v := range o.metadata {
+		header.Set(metaPrefixObs+k, v)
+	}
+	header.Set("ETag", quote(o.etag))
+	header.Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+	header.Set("Accept-Ranges", "bytes")
+	if o.contentType != "" {
+		header.Set("Content-Type", o.contentType)
+	}
+
+	data, status := o.data, http.StatusOK
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+		start, end, ok := parseRange(rangeHeader, int64(len(o.data)))
+		if !ok {
+			header.Set("Content-Range", fmt.Sprintf("bytes */%d", len(o.data)))
+			writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The requested range cannot be satisfied")
+			return
+		}
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(o.data)))
+		data, status = o.data[start:end+1], http.StatusPartialContent
+	}
+	header.Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+func (s *Server) initiateUpload(w http.ResponseWriter, r *http.Request, bucketName, key string) {
+	s.uploadID++
+	uploadID := fmt.Sprintf("upload-%d", s.uploadID)
+	s.uploads[uploadID] = &multipartUpload{
+		bucket:      bucketName,
+		key:         key,
+		contentType: r.Header.Get("Content-Type"),
+		metadata:    readMetadata(r.Header),
+		initiated:   time.Now().UTC(),
+		parts:       make(map[int]*object),
+	}
+	writeXML(w, http.StatusOK, initiateMultipartUploadResult{Bucket: bucketName, Key: key, UploadId: uploadID})
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, r *http.Request, bucketName, key string) {
+	upload, err := s.getUpload(r.URL.Query().Get("uploadId"), bucketName, key)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "NoSuchUpload", err.Error())
+		return
+	}
+	partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > 10000 {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "Part number must be an integer between 1 and 10000")
+		return
+	}
+	part, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadDigest", err.Error())
+		return
+	}
+	upload.parts[partNumber] = part
+	w.Header().Set("ETag", quote(part.etag))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) completeUpload(w http.ResponseWriter, r *http.Request, b *bucket, bucketName, key string) {
+	uploadID := r.URL.Query().Get("uploadId")
+	upload, err := s.getUpload(uploadID, bucketName, key)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "NoSuchUpload", err.Error())
+		return
+	}
+	var request completeMultipartUpload
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil || len(request.Parts) == 0 {
+		writeError(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed")
+		return
+	}
+
+	var data bytes.Buffer
+	var sums []byte
+	lastPart := 0
+	for _, p := range request.Parts {
+		part, ok := upload.parts[p.PartNumber]
+		if !ok || strings.Trim(p.ETag, "\"") != part.etag {
+			writeError(w, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("Part %d is not uploaded or its ETag does not match", p.PartNumber))
+			return
+		}
+		if p.PartNumber <= lastPart {
+			writeError(w, http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order")
+			return
+		}
+		lastPart = p.PartNumber
+		data.Write(part.data)
+		sum, _ := hex.DecodeString(part.etag)
+		sums = append(sums, sum...)
+	}
+	sum := md5.Sum(sums)
+	o := &object{
+		data:         data.Bytes(),
+		contentType:  upload.contentType,
+		metadata:     upload.metadata,
+		etag:         fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(request.Parts)),
+		lastModified: time.Now().UTC(),
+	}
+	b.objects[key] = o
+	delete(s.uploads, uploadID)
+	writeXML(w, http.StatusOK, completeMultipartUploadResult{
+		Location: fmt.Sprintf("%s/%s/%s", s.URL, bucketName, key),
+		Bucket:   bucketName,
+		Key:      key,
+		ETag:     quote(o.etag),
+	})
+}
+
+func (s *Server) getUpload(uploadID, bucketName, key string) (*multipartUpload, error) {
+	upload, ok := s.uploads[uploadID]
+	if !ok || upload.bucket != bucketName || upload.key != key {
+		return nil, fmt.Errorf("the specified upload %s does not exist", uploadID)
+	}
+	return upload, nil
+}
+
+func (s *Server) hasUploads(bucketName string) bool {
+	for _, upload := range s.uploads {
+		if upload.bucket == bucketName {
+			return true
+		}
+	}
+	return false
+}
+
+// Object returns a copy of the object data, or false if the object doesn't exist.
+func (s *Server) Object(bucketName, key string) ([]byte, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	b, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, false
+	}
+	o, ok := b.objects[key]
+	if !ok {
+		return nil, false
+	}
+	return append([]byte(nil), o.data...), true
+}
+
+// Metadata returns a copy of the object user metadata, or false if the object doesn't exist.
+func (s *Server) Metadata(bucketName, key string) (map[string]string, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	b, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, false
+	}
+	o, ok := b.objects[key]
+	if !ok {
+		return nil, false
+	}
+	metadata := make(map[string]string, len(o.metadata))
+	for k, v := range o.metadata {
+		metadata[k] = v
+	}
+	return metadata, true
+}
+
+func readObject(r *http.Request) (*object, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	sum := md5.Sum(data)
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" && contentMD5 != obs.Base64Encode(sum[:]) {
+		return nil, fmt.Errorf("the Content-MD5 you specified did not match what we received")
+	}
+	return &object{
+		data:         data,
+		contentType:  r.Header.Get("Content-Type"),
+		metadata:     readMetadata(r.Header),
+		etag:         hex.EncodeToString(sum[:]),
+		lastModified: time.Now().UTC(),
+	}, nil
+}
+
+func readMetadata(header http.Header) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range header {
+		k = strings.ToLower(k)
+		for _, prefix := range []string{metaPrefixObs, metaPrefixAmz} {
+			if strings.HasPrefix(k, prefix) && len(v) > 0 {
+				metadata[k[len(prefix):]] = v[0]
+			}
+		}
+	}
+	return metadata
+}
+
+// parseRange parses a single byte range and returns its inclusive bounds.
+func parseRange(value string, size int64) (start, end int64, ok bool) {
+	spec := strings.TrimPrefix(value, "bytes=")
+	if spec == value || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+	bounds := strings.SplitN(spec, "-", 2)
+	if len(bounds) != 2 {
+		return 0, 0, false
+	}
+	var err error
+	switch {
+	case bounds[0] == "":
+		suffix, err := strconv.ParseInt(bounds[1], 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, false
+		}
+		if suffix > size {
+			suffix = size
+		}
+		start, end = size-suffix, size-1
+	default:
+		start, err = strconv.ParseInt(bounds[0], 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+		end = size - 1
+		if bounds[1] != "" {
+			end, err = strconv.ParseInt(bounds[1], 10, 64)
+			if err != nil {
+				return 0, 0, false
+			}
+			if end >= size {
+				end = size - 1
+			}
+		}
+	}
+	if start < 0 || start > end || start >= size {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	data, _ := xml.Marshal(errorResponse{Code: code, Message: message, RequestId: "obstest"})
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+func quote(etag string) string {
+	return `"` + etag + `"`
+}
+
+func sortedBucketNames(buckets map[string]*bucket) []string {
+	names := make([]string, 0, len(buckets))
+	for name := range buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedObjectKeys(objects map[string]*object) []string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedUploadIDs(uploads map[string]*multipartUpload) []string {
+	ids := make([]string, 0, len(uploads))
+	for id := range uploads {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
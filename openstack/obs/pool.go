@@ -208,7 +208,9 @@ type RoutinePool struct {
 	wg            *sync.WaitGroup
 	lock          *sync.Mutex
 	shutDownWg    *sync.WaitGroup
-	autoTune      int32
+	// closed when the dispatcher goroutine exits
+	dispatcherDone chan struct{}
+	autoTune       int32
 }
 
 // ErrSubmitTimeout will be returned if submit task timeout when calling SubmitWithTimeout function
@@ -229,11 +231,12 @@ func NewRoutinePool(maxWorkerCnt, cacheCnt int) Pool {
 	}
 
 	pool := &RoutinePool{
-		cacheCnt:   cacheCnt,
-		wg:         new(sync.WaitGroup),
-		lock:       new(sync.Mutex),
-		shutDownWg: new(sync.WaitGroup),
-		autoTune:   0,
+		cacheCnt:       cacheCnt,
+		wg:             new(sync.WaitGroup),
+		lock:           new(sync.Mutex),
+		shutDownWg:     new(sync.WaitGroup),
+		dispatcherDone: make(chan struct{}),
+		autoTune:       0,
 	}
 	pool.isShutDown = 0
 	pool.maxWorkerCnt += int64(maxWorkerCnt)
@@ -269,6 +272,7 @@ func (pool *RoutinePool) checkStatus(t Task) error {
 func (pool *RoutinePool) dispatcher() {
 	pool.shutDownWg.Add(1)
 	go func() {
+		defer close(pool.dispatcherDone)
 		for {
 			task, ok := <-pool.dispatchQueue
 			if !ok {
@@ -419,6 +423,7 @@ func (pool *RoutinePool) beforeCloseDispatchQueue() {
 func (pool *RoutinePool) doCloseDispatchQueue() {
 	close(pool.dispatchQueue)
 	pool.shutDownWg.Wait()
+	<-pool.dispatcherDone
 }
 
 // ShutDown closes the RoutinePool instance
@@ -429,8 +434,6 @@ func (pool *RoutinePool) ShutDown() {
 		w.release()
 	}
 	pool.workers = nil
-	pool.taskQueue = nil
-	pool.dispatchQueue = nil
 }
 
 // NoChanPool defines the coroutine pool struct
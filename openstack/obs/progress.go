@@ -0,0 +1,155 @@
+package obs
+
+import (
+	"io"
+	"sync"
+)
+
+// ProgressEventType defines the type of ProgressEvent
+type ProgressEventType int
+
+const (
+	// TransferStartedEvent is published once before the first byte is transferred.
+	TransferStartedEvent ProgressEventType = 1 + iota
+	// TransferDataEvent is published every time a chunk of data is transferred.
+	TransferDataEvent
+	// TransferPartCompletedEvent is published when a part of UploadFile or DownloadFile is completed.
+	TransferPartCompletedEvent
+	// TransferCompletedEvent is published once the transfer succeeded.
+	TransferCompletedEvent
+	// TransferFailedEvent is published once the transfer failed.
+	TransferFailedEvent
+)
+
+// ProgressEvent defines the state of a transfer
+type ProgressEvent struct {
+	EventType ProgressEventType
+	// ConsumedBytes is the number of bytes transferred so far.
+	ConsumedBytes int64
+	// TotalBytes is the number of bytes to be transferred, -1 if unknown.
+	TotalBytes int64
+	// RwBytes is the number of bytes transferred by this TransferDataEvent.
+	RwBytes int64
+	// PartNumber is set for TransferPartCompletedEvent.
+	PartNumber int
+}
+
+// ProgressListener receives the progress of a transfer.
+//
+// Events are published synchronously from the goroutines doing the transfer,
+// one at a time, so ProgressChanged should return quickly.
+type ProgressListener interface {
+	ProgressChanged(event *ProgressEvent)
+}
+
+// progressTracker counts the bytes of a transfer, publishes them to the
+// listener and applies the rate limiter. A nil tracker does nothing.
+type progressTracker struct {
+	lock     sync.Mutex
+	listener ProgressListener
+	limiter  *RateLimiter
+	consumed int64
+	total    int64
+}
+
+func newProgressTracker(listener ProgressListener, limiter *RateLimiter, total int64) *progressTracker {
+	if listener == nil && limiter == nil {
+		return nil
+	}
+	return &progressTracker{listener: listener, limiter: limiter, total: total}
+}
+
+func (t *progressTracker) publish(eventType ProgressEventType, rwBytes int64, partNumber int) {
+	if t.listener == nil {
+		return
+	}
+	t.listener.ProgressChanged(&ProgressEvent{
+		EventType:     eventType,
+		ConsumedBytes: t.consumed,
+		TotalBytes:    t.total,
+		RwBytes:       rwBytes,
+		PartNumber:    partNumber,
+	})
+}
+
+func (t *progressTracker) setTotal(total int64) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.total = total
+}
+
+// add waits for the rate limiter and publishes n transferred bytes.
+// Negative n rewinds the progress of a retried request.
+func (t *progressTracker) add(n int64) {
+	if t == nil || n == 0 {
+		return
+	}
+	if n > 0 && t.limiter != nil {
+		t.limiter.Wait(n)
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.consumed += n
+	if n > 0 {
+		t.publish(TransferDataEvent, n, 0)
+	}
+}
+
+func (t *progressTracker) event(eventType ProgressEventType, partNumber int) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.publish(eventType, 0, partNumber)
+}
+
+func (t *progressTracker) started() {
+	t.event(TransferStartedEvent, 0)
+}
+
+func (t *progressTracker) partCompleted(partNumber int) {
+	t.event(TransferPartCompletedEvent, partNumber)
+}
+
+// finished publishes TransferCompletedEvent or TransferFailedEvent.
+func (t *progressTracker) finished(err error) {
+	if err != nil {
+		t.event(TransferFailedEvent, 0)
+		return
+	}
+	t.event(TransferCompletedEvent, 0)
+}
+
+// progressReadCloser reports the bytes read from a response body.
+type progressReadCloser struct {
+	io.ReadCloser
+	tracker *progressTracker
+	// own is set when the read closer publishes the final event of the transfer
+	own  bool
+	done bool
+}
+
+func (r *progressReadCloser) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	r.tracker.add(int64(n))
+	if r.own && !r.done && err != nil {
+		r.done = true
+		if err == io.EOF {
+			r.tracker.finished(nil)
+		} else {
+			r.tracker.finished(err)
+		}
+	}
+	return
+}
+
+func (obsClient ObsClient) getRateLimiter(limiter *RateLimiter) *RateLimiter {
+	if limiter != nil {
+		return limiter
+	}
+	return obsClient.conf.rateLimiter
+}
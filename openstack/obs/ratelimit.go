@@ -0,0 +1,56 @@
+package obs
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the bandwidth of transfers using a token bucket.
+//
+// A RateLimiter can be shared by several transfers, limiting their combined
+// bandwidth.
+type RateLimiter struct {
+	lock     sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewRateLimiter creates a RateLimiter allowing bytesPerSecond bytes per
+// second with bursts of at most one second of traffic.
+func NewRateLimiter(bytesPerSecond int64) *RateLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{
+		rate:     float64(bytesPerSecond),
+		capacity: float64(bytesPerSecond),
+		tokens:   float64(bytesPerSecond),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until n bytes may be transferred.
+func (l *RateLimiter) Wait(n int64) {
+	if l == nil || n <= 0 {
+		return
+	}
+	l.lock.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.lock.Unlock()
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,435 @@
+package obs
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	syncMetaMd5   = "md5"
+	syncMetaMtime = "mtime"
+)
+
+// SyncDirection defines the direction of Sync
+type SyncDirection string
+
+const (
+	// SyncUpload makes the bucket prefix a mirror of the local directory.
+	SyncUpload SyncDirection = "upload"
+	// SyncDownload makes the local directory a mirror of the bucket prefix.
+	SyncDownload SyncDirection = "download"
+)
+
+// SyncActionType defines the type of SyncAction
+type SyncActionType string
+
+const (
+	SyncActionUpload   SyncActionType = "upload"
+	SyncActionDownload SyncActionType = "download"
+	SyncActionDelete   SyncActionType = "delete"
+)
+
+// SyncInput is the input parameter of Sync function
+type SyncInput struct {
+	Bucket    string
+	Prefix    string
+	LocalDir  string
+	Direction SyncDirection
+	// Include and Exclude are path.Match patterns matched against the slash separated path
+	// relative to LocalDir and Prefix. Patterns without a slash are matched against the file name too.
+	// A file is synchronized if it matches any Include pattern, or Include is empty,
+	// and matches no Exclude pattern.
+	Include []string
+	Exclude []string
+	// DeleteExtraneous deletes the files missing on the source side from the destination.
+	DeleteExtraneous bool
+	// DryRun only reports the actions, nothing is transferred or deleted.
+	DryRun bool
+	// TaskNum is the number of files transferred concurrently.
+	TaskNum int
+	// PartSize is the part size of UploadFile and DownloadFile, which are used for the files
+	// bigger than PartSize.
+	PartSize int64
+}
+
+// SyncAction describes a single change made by Sync
+type SyncAction struct {
+	Type SyncActionType
+	Key  string
+	Path string
+	Size int64
+	Err  error
+}
+
+// SyncOutput is the result of Sync function
+type SyncOutput struct {
+	Actions    []SyncAction
+	Uploaded   int
+	Downloaded int
+	Deleted    int
+	Unchanged  int
+	Failed     int
+	Bytes      int64
+}
+
+// String returns a summary of the synchronization.
+func (output *SyncOutput) String() string {
+	return fmt.Sprintf("uploaded: %d, downloaded: %d, deleted: %d, unchanged: %d, failed: %d, bytes: %d",
+		output.Uploaded, output.Downloaded, output.Deleted, output.Unchanged, output.Failed, output.Bytes)
+}
+
+type syncLocalFile struct {
+	path  string
+	size  int64
+	mtime int64
+}
+
+// Sync synchronizes a local directory with a bucket prefix.
+//
+// Only the differences are transferred: files are compared by size first, then by the MD5 stored
+// in the object ETag or, for multipart objects, by the MD5 and the modification time stored in
+// the object metadata by Sync. Failed actions are reported in the output and don't stop the others.
+func (obsClient ObsClient) Sync(input *SyncInput) (output *SyncOutput, err error) {
+	if input == nil {
+		return nil, errors.New("SyncInput is nil")
+	}
+	if strings.TrimSpace(input.Bucket) == "" {
+		return nil, errors.New("Bucket is empty")
+	}
+	if strings.TrimSpace(input.LocalDir) == "" {
+		return nil, errors.New("LocalDir is empty")
+	}
+	if input.Direction != SyncUpload && input.Direction != SyncDownload {
+		return nil, fmt.Errorf("invalid sync direction: %s", input.Direction)
+	}
+	for _, pattern := range append(append([]string{}, input.Include...), input.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+		}
+	}
+
+	prefix := input.Prefix
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	local, err := listSyncLocalFiles(input)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := obsClient.listSyncObjects(input, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	output = &SyncOutput{}
+	if input.Direction == SyncUpload {
+		for _, rel := range sortedLocalFileKeys(local) {
+			file := local[rel]
+			if object, ok := remote[rel]; ok && obsClient.isSyncUnchanged(input.Bucket, file, object) {
+				output.Unchanged++
+				continue
+			}
+			output.Actions = append(output.Actions, SyncAction{Type: SyncActionUpload, Key: prefix + rel, Path: file.path, Size: file.size})
+		}
+		if input.DeleteExtraneous {
+			for _, rel := range sortedObjectKeys(remote) {
+				if _, ok := local[rel]; !ok {
+					output.Actions = append(output.Actions, SyncAction{Type: SyncActionDelete, Key: prefix + rel})
+				}
+			}
+		}
+	} else {
+		for _, rel := range sortedObjectKeys(remote) {
+			object := remote[rel]
+			localPath, ok := syncLocalPath(input.LocalDir, rel)
+			if !ok {
+				output.Actions = append(output.Actions, SyncAction{Type: SyncActionDownload, Key: object.Key, Size: object.Size,
+					Err: fmt.Errorf("object key %q resolves to a path outside of %s", object.Key, input.LocalDir)})
+				continue
+			}
+			if file, ok := local[rel]; ok && obsClient.isSyncUnchanged(input.Bucket, file, object) {
+				output.Unchanged++
+				continue
+			}
+			output.Actions = append(output.Actions, SyncAction{Type: SyncActionDownload, Key: object.Key, Path: localPath, Size: object.Size})
+		}
+		if input.DeleteExtraneous {
+			for _, rel := range sortedLocalFileKeys(local) {
+				if _, ok := remote[rel]; !ok {
+					output.Actions = append(output.Actions, SyncAction{Type: SyncActionDelete, Path: local[rel].path})
+				}
+			}
+		}
+	}
+
+	if !input.DryRun {
+		obsClient.runSyncActions(input, output.Actions)
+	}
+	for _, action := range output.Actions {
+		if action.Err != nil {
+			output.Failed++
+			continue
+		}
+		switch action.Type {
+		case SyncActionUpload:
+			output.Uploaded++
+		case SyncActionDownload:
+			output.Downloaded++
+		case SyncActionDelete:
+			output.Deleted++
+		}
+		output.Bytes += action.Size
+	}
+	return output, nil
+}
+
+func (obsClient ObsClient) runSyncActions(input *SyncInput, actions []SyncAction) {
+	taskNum := input.TaskNum
+	if taskNum <= 0 {
+		taskNum = 1
+	}
+	pool := NewRoutinePool(taskNum, MAX_PART_NUM)
+	for i := range actions {
+		action := &actions[i]
+		if action.Err != nil {
+			// rejected while planning
+			continue
+		}
+		pool.ExecuteFunc(func() interface{} {
+			switch action.Type {
+			case SyncActionUpload:
+				action.Err = obsClient.syncUpload(input, action)
+			case SyncActionDownload:
+				action.Err = obsClient.syncDownload(input, action)
+			case SyncActionDelete:
+				if action.Key != "" {
+					_, action.Err = obsClient.DeleteObject(&DeleteObjectInput{Bucket: input.Bucket, Key: action.Key})
+				} else {
+					action.Err = os.Remove(action.Path)
+				}
+			}
+			if action.Err != nil {
+				doLog(LEVEL_WARN, "Failed to %s [%s] with error [%v].", action.Type, action.Key+action.Path, action.Err)
+			}
+			return nil
+		})
+	}
+	pool.ShutDown()
+}
+
+func (obsClient ObsClient) syncUpload(input *SyncInput, action *SyncAction) error {
+	stat, err := os.Stat(action.Path)
+	if err != nil {
+		return err
+	}
+	sum, err := fileMd5(action.Path)
+	if err != nil {
+		return err
+	}
+	metadata := map[string]string{
+		syncMetaMd5:   hex.EncodeToString(sum),
+		syncMetaMtime: Int64ToString(stat.ModTime().Unix()),
+	}
+
+	if input.PartSize > 0 && stat.Size() > input.PartSize {
+		uploadInput := &UploadFileInput{}
+		uploadInput.Bucket = input.Bucket
+		uploadInput.Key = action.Key
+		uploadInput.Metadata = metadata
+		uploadInput.UploadFile = action.Path
+		uploadInput.PartSize = input.PartSize
+		_, err = obsClient.UploadFile(uploadInput)
+		return err
+	}
+
+	putInput := &PutFileInput{}
+	putInput.Bucket = input.Bucket
+	putInput.Key = action.Key
+	putInput.Metadata = metadata
+	putInput.ContentMD5 = Base64Encode(sum)
+	putInput.SourceFile = action.Path
+	_, err = obsClient.PutFile(putInput)
+	return err
+}
+
+func (obsClient ObsClient) syncDownload(input *SyncInput, action *SyncAction) error {
+	if err := os.MkdirAll(filepath.Dir(action.Path), 0755); err != nil {
+		return err
+	}
+	downloadInput := &DownloadFileInput{}
+	downloadInput.Bucket = input.Bucket
+	downloadInput.Key = action.Key
+	downloadInput.DownloadFile = action.Path
+	downloadInput.PartSize = input.PartSize
+	output, err := obsClient.DownloadFile(downloadInput)
+	if err != nil {
+		return err
+	}
+
+	mtime := output.LastModified
+	if value, ok := output.Metadata[syncMetaMtime]; ok {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			mtime = time.Unix(seconds, 0)
+		}
+	}
+	if !mtime.IsZero() {
+		return os.Chtimes(action.Path, mtime, mtime)
+	}
+	return nil
+}
+
+// isSyncUnchanged reports whether the local file has the same content as the object.
+func (obsClient ObsClient) isSyncUnchanged(bucket string, file syncLocalFile, object Content) bool {
+	if file.size != object.Size {
+		return false
+	}
+
+	remoteMd5 := strings.Trim(object.ETag, "\"")
+	if strings.Contains(remoteMd5, "-") {
+		// multipart objects don't have the MD5 as ETag, use the metadata set on upload
+		metadata, err := obsClient.GetObjectMetadata(&GetObjectMetadataInput{Bucket: bucket, Key: object.Key})
+		if err != nil {
+			return false
+		}
+		if metadata.Metadata[syncMetaMtime] == Int64ToString(file.mtime) {
+			return true
+		}
+		remoteMd5 = metadata.Metadata[syncMetaMd5]
+		if remoteMd5 == "" {
+			return false
+		}
+	}
+
+	sum, err := fileMd5(file.path)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(hex.EncodeToString(sum), remoteMd5)
+}
+
+// syncLocalPath returns the local path of the object with the key relative to the prefix. Keys that are
+// absolute paths or resolve to a path outside of localDir, e.g. with ".." elements, are rejected.
+func syncLocalPath(localDir, rel string) (string, bool) {
+	native := filepath.FromSlash(rel)
+	if path.IsAbs(rel) || filepath.IsAbs(native) || filepath.VolumeName(native) != "" {
+		return "", false
+	}
+	root := filepath.Clean(localDir)
+	localPath := filepath.Join(root, native)
+	relPath, err := filepath.Rel(root, localPath)
+	if err != nil || relPath == "." || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return localPath, true
+}
+
+func listSyncLocalFiles(input *SyncInput) (map[string]syncLocalFile, error) {
+	files := make(map[string]syncLocalFile)
+	if _, err := os.Stat(input.LocalDir); os.IsNotExist(err) && input.Direction == SyncDownload {
+		return files, nil
+	}
+	err := filepath.WalkDir(input.LocalDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(input.LocalDir, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !matchSyncFilters(rel, input.Include, input.Exclude) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files[rel] = syncLocalFile{path: filePath, size: info.Size(), mtime: info.ModTime().Unix()}
+		return nil
+	})
+	return files, err
+}
+
+func (obsClient ObsClient) listSyncObjects(input *SyncInput, prefix string) (map[string]Content, error) {
+	objects := make(map[string]Content)
+	listInput := &ListObjectsInput{Bucket: input.Bucket}
+	listInput.Prefix = prefix
+	it := obsClient.NewObjectIterator(listInput)
+	for it.Next() {
+		content := *it.Object()
+		rel := strings.TrimPrefix(content.Key, prefix)
+		if rel == "" || strings.HasSuffix(rel, "/") || !matchSyncFilters(rel, input.Include, input.Exclude) {
+			continue
+		}
+		objects[rel] = content
+	}
+	return objects, it.Err()
+}
+
+func matchSyncFilters(rel string, include, exclude []string) bool {
+	if len(include) > 0 && !matchSyncPatterns(rel, include) {
+		return false
+	}
+	return !matchSyncPatterns(rel, exclude)
+}
+
+func matchSyncPatterns(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedLocalFileKeys(files map[string]syncLocalFile) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedObjectKeys(objects map[string]Content) []string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fileMd5(filePath string) ([]byte, error) {
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, fd); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,160 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+// partRecorder counts the part requests, cancelling the context on the request matching cancelOn.
+// The cancelled request is held until the client abandons it.
+type partRecorder struct {
+	mu        sync.Mutex
+	requests  map[string]int
+	cancelled bool
+	afterStop int
+}
+
+func (p *partRecorder) intercept(part func(r *http.Request) string, cancelOn string, cancel context.CancelFunc) func(w http.ResponseWriter, r *http.Request) bool {
+	return func(w http.ResponseWriter, r *http.Request) bool {
+		id := part(r)
+		if id == "" {
+			return false
+		}
+
+		p.mu.Lock()
+		p.requests[id]++
+		if p.cancelled {
+			p.afterStop++
+		}
+		hold := id == cancelOn && !p.cancelled
+		if hold {
+			p.cancelled = true
+		}
+		p.mu.Unlock()
+
+		if !hold {
+			return false
+		}
+		cancel()
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return true
+	}
+}
+
+func TestUploadFileWithContextCancel(t *testing.T) {
+	server, client := setupServer(t, obs.WithMaxRetryCount(3))
+
+	dir := t.TempDir()
+	sourcePath := filepath.Join(dir, "source")
+	data := testData(350 * 1024)
+	th.AssertNoErr(t, os.WriteFile(sourcePath, data, 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder := &partRecorder{requests: make(map[string]int)}
+	server.Intercept = recorder.intercept(func(r *http.Request) string {
+		return r.URL.Query().Get("partNumber")
+	}, "2", cancel)
+
+	input := &obs.UploadFileInput{}
+	input.Bucket = bucketName
+	input.Key = "large"
+	input.UploadFile = sourcePath
+	input.PartSize = obs.MIN_PART_SIZE
+	input.TaskNum = 1
+	input.EnableCheckpoint = true
+	input.CheckpointFile = filepath.Join(dir, "upload.checkpoint")
+
+	start := time.Now()
+	_, err := client.WithContext(ctx).UploadFile(input)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	th.AssertEquals(t, true, time.Since(start) < 3*time.Second)
+	th.AssertEquals(t, 0, recorder.afterStop)
+	th.AssertDeepEquals(t, map[string]int{"1": 1, "2": 1}, recorder.requests)
+
+	_, err = os.Stat(input.CheckpointFile)
+	th.AssertNoErr(t, err)
+	_, err = os.Stat(input.CheckpointFile + ".tmp")
+	th.AssertEquals(t, true, os.IsNotExist(err))
+
+	_, err = client.UploadFile(input)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]int{"1": 1, "2": 2, "3": 1, "4": 1}, recorder.requests)
+	_, err = os.Stat(input.CheckpointFile)
+	th.AssertEquals(t, true, os.IsNotExist(err))
+
+	uploaded, ok := server.Object(bucketName, input.Key)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, true, bytes.Equal(data, uploaded))
+}
+
+func TestDownloadFileWithContextCancel(t *testing.T) {
+	server, client := setupServer(t, obs.WithMaxRetryCount(3))
+
+	data := testData(350 * 1024)
+	putObject(t, client, "large", data)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	recorder := &partRecorder{requests: make(map[string]int)}
+	server.Intercept = recorder.intercept(func(r *http.Request) string {
+		return r.Header.Get("Range")
+	}, "bytes=102400-204799", cancel)
+
+	dir := t.TempDir()
+	input := &obs.DownloadFileInput{}
+	input.Bucket = bucketName
+	input.Key = "large"
+	input.DownloadFile = filepath.Join(dir, "target")
+	input.PartSize = obs.MIN_PART_SIZE
+	input.TaskNum = 1
+	input.EnableCheckpoint = true
+	input.CheckpointFile = filepath.Join(dir, "download.checkpoint")
+
+	start := time.Now()
+	_, err := client.WithContext(ctx).DownloadFile(input)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	th.AssertEquals(t, true, time.Since(start) < 3*time.Second)
+	th.AssertEquals(t, 0, recorder.afterStop)
+	th.AssertDeepEquals(t, map[string]int{"bytes=0-102399": 1, "bytes=102400-204799": 1}, recorder.requests)
+
+	_, err = os.Stat(input.CheckpointFile)
+	th.AssertNoErr(t, err)
+	_, err = os.Stat(input.CheckpointFile + ".tmp")
+	th.AssertEquals(t, true, os.IsNotExist(err))
+
+	_, err = client.DownloadFile(input)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]int{
+		"bytes=0-102399":      1,
+		"bytes=102400-204799": 2,
+		"bytes=204800-307199": 1,
+		"bytes=307200-358399": 1,
+	}, recorder.requests)
+	_, err = os.Stat(input.CheckpointFile)
+	th.AssertEquals(t, true, os.IsNotExist(err))
+
+	downloaded, err := os.ReadFile(input.DownloadFile)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, bytes.Equal(data, downloaded))
+}
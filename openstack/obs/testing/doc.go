@@ -0,0 +1,2 @@
+// obs unit tests
+package testing
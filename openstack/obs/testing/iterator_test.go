@@ -0,0 +1,238 @@
+package testing
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+// isBucketList reports whether the request lists the bucket with the given sub-resource.
+func isBucketList(r *http.Request, subResource string) bool {
+	if r.Method != http.MethodGet || strings.Trim(r.URL.Path, "/") != bucketName {
+		return false
+	}
+	query := r.URL.Query()
+	if subResource == "" {
+		return !query.Has("versions") && !query.Has("uploads")
+	}
+	return query.Has(subResource)
+}
+
+func TestObjectIteratorPages(t *testing.T) {
+	server, client := setupServer(t)
+	for _, key := range []string{"a.txt", "b/1", "b/2", "c/1", "d.txt", "e/x", "f.txt"} {
+		putObject(t, client, key, []byte(key))
+	}
+
+	var markers []string
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		if isBucketList(r, "") {
+			markers = append(markers, r.URL.Query().Get("marker"))
+		}
+		return false
+	}
+
+	input := &obs.ListObjectsInput{Bucket: bucketName}
+	input.MaxKeys = 3
+	var keys []string
+	it := client.NewObjectIterator(input)
+	for it.Next() {
+		th.AssertEquals(t, "", it.CommonPrefix())
+		keys = append(keys, it.Object().Key)
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertDeepEquals(t, []string{"a.txt", "b/1", "b/2", "c/1", "d.txt", "e/x", "f.txt"}, keys)
+	th.AssertDeepEquals(t, []string{"", "b/2", "e/x"}, markers)
+	th.AssertEquals(t, "", input.Marker)
+
+	markers = nil
+	input.MaxKeys = 2
+	input.Delimiter = "/"
+	var items []string
+	it = client.NewObjectIterator(input)
+	for it.Next() {
+		if object := it.Object(); object != nil {
+			items = append(items, object.Key)
+		} else {
+			items = append(items, it.CommonPrefix())
+		}
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertDeepEquals(t, []string{"a.txt", "b/", "d.txt", "c/", "f.txt", "e/"}, items)
+	th.AssertDeepEquals(t, []string{"", "b/", "d.txt"}, markers)
+}
+
+// listPage is a ListObjects response without NextMarker.
+type listPage struct {
+	keys      []string
+	prefixes  []string
+	truncated bool
+}
+
+func serveListPages(t *testing.T, pages map[string]listPage, markers *[]string) func(w http.ResponseWriter, r *http.Request) bool {
+	return func(w http.ResponseWriter, r *http.Request) bool {
+		if !isBucketList(r, "") {
+			return false
+		}
+		marker := r.URL.Query().Get("marker")
+		*markers = append(*markers, marker)
+		page, ok := pages[marker]
+		if !ok {
+			t.Errorf("unexpected marker %q", marker)
+		}
+
+		body := fmt.Sprintf("<ListBucketResult><Name>%s</Name><Marker>%s</Marker><IsTruncated>%t</IsTruncated>", bucketName, marker, page.truncated)
+		for _, key := range page.keys {
+			body += fmt.Sprintf("<Contents><Key>%s</Key></Contents>", key)
+		}
+		for _, prefix := range page.prefixes {
+			body += fmt.Sprintf("<CommonPrefixes><Prefix>%s</Prefix></CommonPrefixes>", prefix)
+		}
+		body += "</ListBucketResult>"
+
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = io.WriteString(w, body)
+		return true
+	}
+}
+
+func TestObjectIteratorMarkerFallback(t *testing.T) {
+	server, client := setupServer(t)
+
+	var markers []string
+	server.Intercept = serveListPages(t, map[string]listPage{
+		"":   {keys: []string{"a", "b"}, truncated: true},
+		"b":  {keys: []string{"c"}, prefixes: []string{"d/"}, truncated: true},
+		"d/": {keys: []string{"e"}},
+	}, &markers)
+
+	var items []string
+	it := client.NewObjectIterator(&obs.ListObjectsInput{Bucket: bucketName})
+	for it.Next() {
+		if object := it.Object(); object != nil {
+			items = append(items, object.Key)
+		} else {
+			items = append(items, it.CommonPrefix())
+		}
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertDeepEquals(t, []string{"a", "b", "c", "d/", "e"}, items)
+	th.AssertDeepEquals(t, []string{"", "b", "d/"}, markers)
+}
+
+func TestObjectIteratorMarkerNotAdvanced(t *testing.T) {
+	server, client := setupServer(t)
+
+	var markers []string
+	server.Intercept = serveListPages(t, map[string]listPage{
+		"":  {keys: []string{"a"}, truncated: true},
+		"a": {truncated: true},
+	}, &markers)
+
+	var keys []string
+	it := client.NewObjectIterator(&obs.ListObjectsInput{Bucket: bucketName})
+	for it.Next() {
+		keys = append(keys, it.Object().Key)
+	}
+	th.AssertEquals(t, "listing marker did not advance", it.Err().Error())
+	th.AssertEquals(t, false, it.Next())
+	th.AssertDeepEquals(t, []string{"a"}, keys)
+	th.AssertDeepEquals(t, []string{"", "a"}, markers)
+}
+
+func TestVersionIteratorPages(t *testing.T) {
+	server, client := setupServer(t)
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		putObject(t, client, key, []byte(key))
+	}
+
+	var markers []string
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		if isBucketList(r, "versions") {
+			markers = append(markers, r.URL.Query().Get("key-marker"))
+		}
+		return false
+	}
+
+	input := &obs.ListVersionsInput{Bucket: bucketName}
+	input.MaxKeys = 2
+	var keys []string
+	it := client.NewVersionIterator(input)
+	for it.Next() {
+		th.AssertEquals(t, (*obs.DeleteMarker)(nil), it.DeleteMarker())
+		keys = append(keys, it.Version().Key)
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertDeepEquals(t, []string{"a", "b", "c", "d", "e"}, keys)
+	th.AssertDeepEquals(t, []string{"", "b", "d"}, markers)
+}
+
+func initiateUploads(t *testing.T, client *obs.ObsClient, keys ...string) {
+	for _, key := range keys {
+		input := &obs.InitiateMultipartUploadInput{}
+		input.Bucket = bucketName
+		input.Key = key
+		_, err := client.InitiateMultipartUpload(input)
+		th.AssertNoErr(t, err)
+	}
+}
+
+func TestMultipartUploadIteratorPages(t *testing.T) {
+	server, client := setupServer(t)
+	initiateUploads(t, client, "b", "a", "b", "c", "a")
+
+	var markers []string
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		if isBucketList(r, "uploads") {
+			query := r.URL.Query()
+			markers = append(markers, query.Get("key-marker")+"|"+query.Get("upload-id-marker"))
+		}
+		return false
+	}
+
+	var uploads []string
+	it := client.NewMultipartUploadIterator(&obs.ListMultipartUploadsInput{Bucket: bucketName, MaxUploads: 2})
+	for it.Next() {
+		uploads = append(uploads, it.Upload().Key+"|"+it.Upload().UploadId)
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertDeepEquals(t, []string{"a|upload-2", "a|upload-5", "b|upload-1", "b|upload-3", "c|upload-4"}, uploads)
+	th.AssertDeepEquals(t, []string{"|", "a|upload-5", "b|upload-3"}, markers)
+}
+
+func TestEmptyBucketBatches(t *testing.T) {
+	server, client := setupServer(t)
+	for i := 0; i < 2100; i++ {
+		putObject(t, client, fmt.Sprintf("object-%04d", i), []byte("data"))
+	}
+	initiateUploads(t, client, "pending-1", "pending-2", "pending-3")
+
+	var batches []int
+	var aborted int
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+			body, err := io.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			batches = append(batches, strings.Count(string(body), "<Object>"))
+			r.Body = io.NopCloser(strings.NewReader(string(body)))
+		case r.Method == http.MethodDelete && r.URL.Query().Has("uploadId"):
+			aborted++
+		}
+		return false
+	}
+
+	th.AssertNoErr(t, client.EmptyBucket(bucketName))
+	th.AssertDeepEquals(t, []int{1000, 1000, 100}, batches)
+	th.AssertEquals(t, 3, aborted)
+
+	_, ok := server.Object(bucketName, "object-0000")
+	th.AssertEquals(t, false, ok)
+	_, err := client.DeleteBucket(bucketName)
+	th.AssertNoErr(t, err)
+}
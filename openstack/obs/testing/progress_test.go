@@ -0,0 +1,142 @@
+package testing
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+type recordingListener struct {
+	mu     sync.Mutex
+	events []obs.ProgressEvent
+}
+
+func (l *recordingListener) ProgressChanged(event *obs.ProgressEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, *event)
+}
+
+type putRequest struct {
+	contentLength    int64
+	transferEncoding []string
+}
+
+func TestPutObjectContentLength(t *testing.T) {
+	bodies := map[string]func() io.Reader{
+		"strings.Reader": func() io.Reader { return strings.NewReader("hello") },
+		"bytes.Reader":   func() io.Reader { return bytes.NewReader([]byte("hello")) },
+		"bytes.Buffer":   func() io.Reader { return bytes.NewBufferString("hello") },
+	}
+	configurers := map[string][]obs.Configurer{
+		"no limit":   nil,
+		"rate limit": {obs.WithRateLimit(1024 * 1024)},
+	}
+
+	for configName, configurer := range configurers {
+		for bodyName, body := range bodies {
+			for _, withListener := range []bool{false, true} {
+				server, client := setupServer(t, configurer...)
+
+				var requests []putRequest
+				server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+					if r.Method == http.MethodPut && r.URL.Path != "/"+bucketName {
+						requests = append(requests, putRequest{contentLength: r.ContentLength, transferEncoding: r.TransferEncoding})
+					}
+					return false
+				}
+
+				listener := &recordingListener{}
+				input := &obs.PutObjectInput{}
+				input.Bucket = bucketName
+				input.Key = "object"
+				input.Body = body()
+				if withListener {
+					input.ProgressListener = listener
+				}
+				_, err := client.PutObject(input)
+				th.AssertNoErr(t, err)
+
+				name := configName + " " + bodyName
+				th.AssertEquals(t, 1, len(requests))
+				if requests[0].contentLength != 5 || len(requests[0].transferEncoding) != 0 {
+					t.Errorf("%s, listener %v: Content-Length %d, Transfer-Encoding %v", name, withListener,
+						requests[0].contentLength, requests[0].transferEncoding)
+				}
+				data, _ := server.Object(bucketName, "object")
+				th.AssertEquals(t, "hello", string(data))
+
+				if withListener {
+					events := listener.events
+					th.AssertEquals(t, obs.TransferStartedEvent, events[0].EventType)
+					last := events[len(events)-1]
+					th.AssertEquals(t, obs.TransferCompletedEvent, last.EventType)
+					th.AssertEquals(t, int64(5), last.ConsumedBytes)
+					for _, event := range events {
+						th.AssertEquals(t, int64(5), event.TotalBytes)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPutObjectUnknownLength(t *testing.T) {
+	server, client := setupServer(t)
+
+	var contentLength int64
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method == http.MethodPut {
+			contentLength = r.ContentLength
+		}
+		return false
+	}
+
+	listener := &recordingListener{}
+	input := &obs.PutObjectInput{}
+	input.Bucket = bucketName
+	input.Key = "object"
+	input.Body = io.MultiReader(strings.NewReader("hel"), strings.NewReader("lo"))
+	input.ProgressListener = listener
+	_, err := client.PutObject(input)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, int64(-1), contentLength)
+	last := listener.events[len(listener.events)-1]
+	th.AssertEquals(t, obs.TransferCompletedEvent, last.EventType)
+	th.AssertEquals(t, int64(5), last.ConsumedBytes)
+	th.AssertEquals(t, int64(-1), last.TotalBytes)
+}
+
+func TestPutObjectReusedInput(t *testing.T) {
+	server, client := setupServer(t)
+
+	var contentLengths []int64
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method == http.MethodPut {
+			contentLengths = append(contentLengths, r.ContentLength)
+		}
+		return false
+	}
+
+	input := &obs.PutObjectInput{}
+	input.Bucket = bucketName
+	input.Key = "object"
+	input.ProgressListener = &recordingListener{}
+	for _, content := range []string{"hello", "hello world"} {
+		input.Body = bytes.NewReader([]byte(content))
+		_, err := client.PutObject(input)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, int64(0), input.ContentLength)
+
+		data, _ := server.Object(bucketName, "object")
+		th.AssertEquals(t, content, string(data))
+	}
+	th.AssertDeepEquals(t, []int64{5, 11}, contentLengths)
+}
@@ -0,0 +1,238 @@
+package testing
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs/obstest"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const bucketName = "test-bucket"
+
+func setupServer(t *testing.T, configurers ...obs.Configurer) (*obstest.Server, *obs.ObsClient) {
+	server := obstest.NewServer("test-ak", "test-sk")
+	t.Cleanup(server.Close)
+
+	client, err := server.Client(configurers...)
+	th.AssertNoErr(t, err)
+	t.Cleanup(client.Close)
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{Bucket: bucketName})
+	th.AssertNoErr(t, err)
+	return server, client
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestObjectRoundTrip(t *testing.T) {
+	signatures := []obs.SignatureType{obs.SignatureV2, obs.SignatureV4, obs.SignatureObs}
+	for _, signature := range signatures {
+		t.Run(string(signature), func(t *testing.T) {
+			server, client := setupServer(t, obs.WithSignature(signature))
+
+			putInput := &obs.PutObjectInput{}
+			putInput.Bucket = bucketName
+			putInput.Key = "dir/some key+=.txt"
+			putInput.Metadata = map[string]string{"owner": "tester"}
+			putInput.Body = bytes.NewReader([]byte("hello world"))
+			_, err := client.PutObject(putInput)
+			th.AssertNoErr(t, err)
+
+			data, ok := server.Object(bucketName, putInput.Key)
+			th.AssertEquals(t, true, ok)
+			th.AssertEquals(t, "hello world", string(data))
+
+			getInput := &obs.GetObjectInput{}
+			getInput.Bucket = bucketName
+			getInput.Key = putInput.Key
+			getInput.RangeStart = 6
+			getInput.RangeEnd = 10
+			output, err := client.GetObject(getInput)
+			th.AssertNoErr(t, err)
+			body, err := io.ReadAll(output.Body)
+			th.AssertNoErr(t, err)
+			th.AssertNoErr(t, output.Body.Close())
+			th.AssertEquals(t, "world", string(body))
+			th.AssertEquals(t, http.StatusPartialContent, output.StatusCode)
+			th.AssertEquals(t, "tester", output.Metadata["owner"])
+
+			_, err = client.DeleteObject(&obs.DeleteObjectInput{Bucket: bucketName, Key: putInput.Key})
+			th.AssertNoErr(t, err)
+			_, ok = server.Object(bucketName, putInput.Key)
+			th.AssertEquals(t, false, ok)
+		})
+	}
+}
+
+func TestBadSignature(t *testing.T) {
+	server, _ := setupServer(t)
+
+	client, err := obs.New(server.AccessKey, "wrong-sk", server.URL, obs.WithPathStyle(true), obs.WithMaxRetryCount(0))
+	th.AssertNoErr(t, err)
+	defer client.Close()
+
+	_, err = client.ListObjects(&obs.ListObjectsInput{Bucket: bucketName})
+	obsError, ok := err.(obs.ObsError)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, http.StatusForbidden, obsError.StatusCode)
+	th.AssertEquals(t, "SignatureDoesNotMatch", obsError.Code)
+}
+
+func TestListObjectsDelimiter(t *testing.T) {
+	_, client := setupServer(t)
+
+	for _, key := range []string{"a.txt", "logs/1.log", "logs/2.log", "tmp/x", "z.txt"} {
+		input := &obs.PutObjectInput{}
+		input.Bucket = bucketName
+		input.Key = key
+		input.Body = bytes.NewReader([]byte(key))
+		_, err := client.PutObject(input)
+		th.AssertNoErr(t, err)
+	}
+
+	input := &obs.ListObjectsInput{Bucket: bucketName}
+	input.Delimiter = "/"
+	output, err := client.ListObjects(input)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"logs/", "tmp/"}, output.CommonPrefixes)
+	th.AssertEquals(t, 2, len(output.Contents))
+	th.AssertEquals(t, "a.txt", output.Contents[0].Key)
+	th.AssertEquals(t, "z.txt", output.Contents[1].Key)
+
+	input = &obs.ListObjectsInput{Bucket: bucketName}
+	input.MaxKeys = 2
+	var keys []string
+	it := client.NewObjectIterator(input)
+	for it.Next() {
+		keys = append(keys, it.Object().Key)
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertDeepEquals(t, []string{"a.txt", "logs/1.log", "logs/2.log", "tmp/x", "z.txt"}, keys)
+
+	_, err = client.DeleteBucket(bucketName)
+	th.AssertEquals(t, "BucketNotEmpty", err.(obs.ObsError).Code)
+	th.AssertNoErr(t, client.EmptyBucket(bucketName))
+	_, err = client.DeleteBucket(bucketName)
+	th.AssertNoErr(t, err)
+}
+
+func TestUploadFileResume(t *testing.T) {
+	server, client := setupServer(t)
+
+	dir := t.TempDir()
+	sourcePath := filepath.Join(dir, "source")
+	data := testData(350 * 1024)
+	th.AssertNoErr(t, os.WriteFile(sourcePath, data, 0600))
+
+	var mu sync.Mutex
+	uploadedParts := make(map[string]int)
+	failPart := "3"
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		partNumber := r.URL.Query().Get("partNumber")
+		if partNumber == "" {
+			return false
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if partNumber == failPart {
+			failPart = ""
+			w.WriteHeader(http.StatusInternalServerError)
+			return true
+		}
+		uploadedParts[partNumber]++
+		return false
+	}
+
+	input := &obs.UploadFileInput{}
+	input.Bucket = bucketName
+	input.Key = "large"
+	input.UploadFile = sourcePath
+	input.PartSize = obs.MIN_PART_SIZE
+	input.TaskNum = 1
+	input.EnableCheckpoint = true
+	input.CheckpointFile = filepath.Join(dir, "upload.checkpoint")
+
+	_, err := client.UploadFile(input)
+	th.AssertEquals(t, true, err != nil)
+	_, err = os.Stat(input.CheckpointFile)
+	th.AssertNoErr(t, err)
+
+	_, err = client.UploadFile(input)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]int{"1": 1, "2": 1, "3": 1, "4": 1}, uploadedParts)
+	_, err = os.Stat(input.CheckpointFile)
+	th.AssertEquals(t, true, os.IsNotExist(err))
+
+	uploaded, ok := server.Object(bucketName, input.Key)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, true, bytes.Equal(data, uploaded))
+}
+
+func TestDownloadFileResume(t *testing.T) {
+	server, client := setupServer(t)
+
+	data := testData(350 * 1024)
+	putInput := &obs.PutObjectInput{}
+	putInput.Bucket = bucketName
+	putInput.Key = "large"
+	putInput.Body = bytes.NewReader(data)
+	_, err := client.PutObject(putInput)
+	th.AssertNoErr(t, err)
+
+	var mu sync.Mutex
+	ranges := make(map[string]int)
+	failRange := "bytes=204800-307199"
+	server.Intercept = func(w http.ResponseWriter, r *http.Request) bool {
+		rangeHeader := r.Header.Get("Range")
+		if rangeHeader == "" {
+			return false
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if rangeHeader == failRange {
+			failRange = ""
+			w.WriteHeader(http.StatusInternalServerError)
+			return true
+		}
+		ranges[rangeHeader]++
+		return false
+	}
+
+	dir := t.TempDir()
+	input := &obs.DownloadFileInput{}
+	input.Bucket = bucketName
+	input.Key = putInput.Key
+	input.DownloadFile = filepath.Join(dir, "target")
+	input.PartSize = obs.MIN_PART_SIZE
+	input.TaskNum = 1
+	input.EnableCheckpoint = true
+	input.CheckpointFile = filepath.Join(dir, "download.checkpoint")
+
+	_, err = client.DownloadFile(input)
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = client.DownloadFile(input)
+	th.AssertNoErr(t, err)
+	for r, count := range ranges {
+		th.AssertEquals(t, 1, count)
+		th.AssertEquals(t, true, r != "")
+	}
+	th.AssertEquals(t, 4, len(ranges))
+
+	downloaded, err := os.ReadFile(input.DownloadFile)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, bytes.Equal(data, downloaded))
+}
@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func putObject(t *testing.T, client *obs.ObsClient, key string, data []byte) {
+	input := &obs.PutObjectInput{}
+	input.Bucket = bucketName
+	input.Key = key
+	input.Body = bytes.NewReader(data)
+	_, err := client.PutObject(input)
+	th.AssertNoErr(t, err)
+}
+
+func writeFile(t *testing.T, name string, data string) {
+	th.AssertNoErr(t, os.MkdirAll(filepath.Dir(name), 0755))
+	th.AssertNoErr(t, os.WriteFile(name, []byte(data), 0600))
+}
+
+func actionKeys(actions []obs.SyncAction) []string {
+	var keys []string
+	for _, action := range actions {
+		keys = append(keys, string(action.Type)+" "+action.Key+filepath.ToSlash(action.Path))
+	}
+	return keys
+}
+
+func TestSyncUpload(t *testing.T) {
+	server, client := setupServer(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.txt"), "first")
+	writeFile(t, filepath.Join(dir, "sub", "b.log"), "second")
+	writeFile(t, filepath.Join(dir, "skip.tmp"), "temporary")
+
+	input := &obs.SyncInput{
+		Bucket:    bucketName,
+		Prefix:    "backup",
+		LocalDir:  dir,
+		Direction: obs.SyncUpload,
+		Exclude:   []string{"*.tmp"},
+	}
+	output, err := client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, output.Uploaded)
+	th.AssertEquals(t, int64(11), output.Bytes)
+
+	data, ok := server.Object(bucketName, "backup/sub/b.log")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "second", string(data))
+	_, ok = server.Object(bucketName, "backup/skip.tmp")
+	th.AssertEquals(t, false, ok)
+
+	output, err = client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, output.Unchanged)
+	th.AssertEquals(t, 0, len(output.Actions))
+
+	writeFile(t, filepath.Join(dir, "a.txt"), "changed")
+	putObject(t, client, "backup/old.txt", []byte("old"))
+	input.DeleteExtraneous = true
+	input.DryRun = true
+
+	output, err = client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"upload backup/a.txt" + filepath.ToSlash(filepath.Join(dir, "a.txt")), "delete backup/old.txt"}, actionKeys(output.Actions))
+	data, _ = server.Object(bucketName, "backup/a.txt")
+	th.AssertEquals(t, "first", string(data))
+	_, ok = server.Object(bucketName, "backup/old.txt")
+	th.AssertEquals(t, true, ok)
+
+	input.DryRun = false
+	output, err = client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, output.Uploaded)
+	th.AssertEquals(t, 1, output.Deleted)
+	th.AssertEquals(t, 0, output.Failed)
+	data, _ = server.Object(bucketName, "backup/a.txt")
+	th.AssertEquals(t, "changed", string(data))
+	_, ok = server.Object(bucketName, "backup/old.txt")
+	th.AssertEquals(t, false, ok)
+}
+
+func TestSyncDownload(t *testing.T) {
+	_, client := setupServer(t)
+
+	putObject(t, client, "data/x.txt", []byte("x"))
+	putObject(t, client, "data/dir/y.txt", []byte("yy"))
+	putObject(t, client, "data/z.log", []byte("zzz"))
+	putObject(t, client, "other/w.txt", []byte("w"))
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "extra.txt"), "extra")
+	writeFile(t, filepath.Join(dir, "keep.log"), "excluded by include")
+
+	input := &obs.SyncInput{
+		Bucket:           bucketName,
+		Prefix:           "data/",
+		LocalDir:         dir,
+		Direction:        obs.SyncDownload,
+		Include:          []string{"*.txt"},
+		DeleteExtraneous: true,
+		DryRun:           true,
+	}
+	output, err := client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(output.Actions))
+	_, err = os.Stat(filepath.Join(dir, "x.txt"))
+	th.AssertEquals(t, true, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "extra.txt"))
+	th.AssertNoErr(t, err)
+
+	input.DryRun = false
+	output, err = client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, output.Downloaded)
+	th.AssertEquals(t, 1, output.Deleted)
+	th.AssertEquals(t, 0, output.Failed)
+
+	data, err := os.ReadFile(filepath.Join(dir, "dir", "y.txt"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "yy", string(data))
+	_, err = os.Stat(filepath.Join(dir, "z.log"))
+	th.AssertEquals(t, true, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "extra.txt"))
+	th.AssertEquals(t, true, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "keep.log"))
+	th.AssertNoErr(t, err)
+
+	output, err = client.Sync(input)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, output.Unchanged)
+	th.AssertEquals(t, 0, len(output.Actions))
+}
+
+func TestSyncDownloadTraversal(t *testing.T) {
+	_, client := setupServer(t)
+
+	putObject(t, client, "data/../../evil.txt", []byte("evil"))
+	putObject(t, client, "data//absolute.txt", []byte("absolute"))
+	putObject(t, client, "data/good.txt", []byte("good"))
+
+	base := t.TempDir()
+	dir := filepath.Join(base, "a", "b")
+
+	output, err := client.Sync(&obs.SyncInput{
+		Bucket:    bucketName,
+		Prefix:    "data",
+		LocalDir:  dir,
+		Direction: obs.SyncDownload,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, output.Downloaded)
+	th.AssertEquals(t, 2, output.Failed)
+	for _, action := range output.Actions {
+		th.AssertEquals(t, action.Key != "data/good.txt", action.Err != nil)
+	}
+
+	_, err = os.Stat(filepath.Join(base, "evil.txt"))
+	th.AssertEquals(t, true, os.IsNotExist(err))
+	_, err = os.Stat("/absolute.txt")
+	th.AssertEquals(t, true, os.IsNotExist(err))
+	data, err := os.ReadFile(filepath.Join(dir, "good.txt"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "good", string(data))
+}
@@ -827,11 +827,39 @@ func (parts partSlice) Swap(i, j int) {
 	parts[i], parts[j] = parts[j], parts[i]
 }
 
+// readerLength returns the number of unread bytes of the standard in-memory readers and of seekable readers.
+func readerLength(reader io.Reader) (int64, bool) {
+	switch r := reader.(type) {
+	case *strings.Reader:
+		return int64(r.Len()), true
+	case *bytes.Reader:
+		return int64(r.Len()), true
+	case *bytes.Buffer:
+		return int64(r.Len()), true
+	case io.Seeker:
+		current, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, false
+		}
+		if _, err := r.Seek(current, io.SeekStart); err != nil {
+			return 0, false
+		}
+		return end - current, true
+	}
+	return 0, false
+}
+
 type readerWrapper struct {
 	reader      io.Reader
 	mark        int64 // nolint: structcheck
 	totalCount  int64
 	readedCount int64
+	tracker     *progressTracker
+	reported    int64
 }
 
 func (rw *readerWrapper) seek(offset int64, whence int) (int64, error) {
@@ -845,7 +873,22 @@ func (rw *readerWrapper) seek(offset int64, whence int) (int64, error) {
 	return offset, nil
 }
 
+// rewindProgress takes back the progress reported before a retry.
+func (rw *readerWrapper) rewindProgress() {
+	rw.tracker.add(-rw.reported)
+	rw.reported = 0
+}
+
 func (rw *readerWrapper) Read(p []byte) (n int, err error) {
+	n, err = rw.read(p)
+	if n > 0 && rw.tracker != nil {
+		rw.tracker.add(int64(n))
+		rw.reported += int64(n)
+	}
+	return
+}
+
+func (rw *readerWrapper) read(p []byte) (n int, err error) {
 	if rw.totalCount == 0 {
 		return 0, io.EOF
 	}
@@ -905,3 +948,66 @@ func (input GetBucketInventoryInput) trans(isObs bool) (params map[string]string
 
 	return
 }
+
+func (input SetObjectTaggingInput) trans(_ bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params = map[string]string{string(SubResourceTagging): ""}
+	if input.VersionId != "" {
+		params[PARAM_VERSION_ID] = input.VersionId
+	}
+	data, md5, err := ConvertRequestToIoReaderV2(input)
+	if err != nil {
+		return
+	}
+	headers = map[string][]string{HEADER_MD5_CAMEL: {md5}}
+	return
+}
+
+func (input GetObjectTaggingInput) trans(_ bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params = map[string]string{string(SubResourceTagging): ""}
+	if input.VersionId != "" {
+		params[PARAM_VERSION_ID] = input.VersionId
+	}
+	return
+}
+
+func (input DeleteObjectTaggingInput) trans(_ bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params = map[string]string{string(SubResourceTagging): ""}
+	if input.VersionId != "" {
+		params[PARAM_VERSION_ID] = input.VersionId
+	}
+	return
+}
+
+func (input AppendObjectInput) trans(isObs bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params, headers, data, err = input.PutObjectBasicInput.trans(isObs)
+	if err != nil {
+		return
+	}
+	params[string(SubResourceAppend)] = ""
+	params[PARAM_POSITION] = Int64ToString(input.Position)
+	if input.Body != nil {
+		data = input.Body
+	}
+	return
+}
+
+func (input SetObjectRetentionInput) trans(_ bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params = map[string]string{string(SubResourceRetention): ""}
+	if input.VersionId != "" {
+		params[PARAM_VERSION_ID] = input.VersionId
+	}
+	data, md5, err := ConvertRequestToIoReaderV2(input)
+	if err != nil {
+		return
+	}
+	headers = map[string][]string{HEADER_MD5_CAMEL: {md5}}
+	return
+}
+
+func (input GetObjectRetentionInput) trans(_ bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params = make(map[string]string)
+	if input.VersionId != "" {
+		params[PARAM_VERSION_ID] = input.VersionId
+	}
+	return
+}
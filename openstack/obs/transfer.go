@@ -2,6 +2,7 @@ package obs
 
 import (
 	"bufio"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -71,7 +72,7 @@ type uploadPartTask struct {
 }
 
 func (task *uploadPartTask) Run() interface{} {
-	if atomic.LoadInt32(task.abort) == 1 {
+	if atomic.LoadInt32(task.abort) == 1 || task.obsClient.context().Err() != nil {
 		return errAbort
 	}
 
@@ -84,6 +85,7 @@ func (task *uploadPartTask) Run() interface{} {
 	input.SourceFile = task.SourceFile
 	input.Offset = task.Offset
 	input.PartSize = task.PartSize
+	input.tracker = task.tracker
 
 	var output *UploadPartOutput
 	var err error
@@ -102,6 +104,9 @@ func (task *uploadPartTask) Run() interface{} {
 	} else if obsError, ok := err.(ObsError); ok && obsError.StatusCode >= 400 && obsError.StatusCode < 500 {
 		atomic.CompareAndSwapInt32(task.abort, 0, 1)
 		doLog(LEVEL_WARN, "Task is aborted, part number is [%d]", task.PartNumber)
+	} else if task.obsClient.context().Err() != nil {
+		atomic.CompareAndSwapInt32(task.abort, 0, 1)
+		doLog(LEVEL_WARN, "Task is cancelled, part number is [%d]", task.PartNumber)
 	}
 	return err
 }
@@ -117,13 +122,18 @@ func loadCheckpointFile(checkpointFile string, result interface{}) error {
 	return xml.Unmarshal(ret, result)
 }
 
+// updateCheckpointFile replaces the checkpoint file atomically, so an interrupted
+// transfer never leaves a truncated checkpoint behind.
 func updateCheckpointFile(fc interface{}, checkpointFilePath string) error {
 	result, err := xml.Marshal(fc)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(checkpointFilePath, result, 0666)
-	return err
+	tempFilePath := checkpointFilePath + ".tmp"
+	if err = ioutil.WriteFile(tempFilePath, result, 0666); err != nil {
+		return err
+	}
+	return os.Rename(tempFilePath, checkpointFilePath)
 }
 
 func getCheckpointFile(ufc *UploadCheckpoint, uploadFileStat os.FileInfo, input *UploadFileInput, obsClient *ObsClient) (needCheckpoint bool, err error) {
@@ -237,6 +247,10 @@ func handleUploadFileResult(uploadPartError error, ufc *UploadCheckpoint, enable
 		if enableCheckpoint {
 			return uploadPartError
 		}
+		if obsClient.context().Err() != nil {
+			// the upload is cancelled, but the parts still have to be cleaned up
+			obsClient = obsClient.WithContext(context.Background())
+		}
 		_err := abortTask(ufc.Bucket, ufc.Key, ufc.UploadId, obsClient)
 		if _err != nil {
 			doLog(LEVEL_WARN, "Failed to abort task [%s].", ufc.UploadId)
@@ -321,13 +335,25 @@ func (obsClient ObsClient) resumeUpload(input *UploadFileInput) (output *Complet
 		}
 	}
 
-	uploadPartError := obsClient.uploadPartConcurrent(ufc, checkpointFilePath, input)
+	tracker := newProgressTracker(input.ProgressListener, obsClient.getRateLimiter(input.RateLimiter), uploadFileStat.Size())
+	if tracker != nil {
+		for _, uploadPart := range ufc.UploadParts {
+			if uploadPart.IsCompleted {
+				tracker.consumed += uploadPart.PartSize
+			}
+		}
+	}
+	tracker.started()
+
+	uploadPartError := obsClient.uploadPartConcurrent(ufc, checkpointFilePath, input, tracker)
 	err = handleUploadFileResult(uploadPartError, ufc, enableCheckpoint, &obsClient)
 	if err != nil {
+		tracker.finished(err)
 		return nil, err
 	}
 
 	completeOutput, err := completeParts(ufc, enableCheckpoint, checkpointFilePath, &obsClient, input.EncodingType)
+	tracker.finished(err)
 
 	return completeOutput, err
 }
@@ -352,14 +378,14 @@ func handleUploadTaskResult(result interface{}, ufc *UploadCheckpoint, partNum i
 	return
 }
 
-func (obsClient ObsClient) uploadPartConcurrent(ufc *UploadCheckpoint, checkpointFilePath string, input *UploadFileInput) error {
+func (obsClient ObsClient) uploadPartConcurrent(ufc *UploadCheckpoint, checkpointFilePath string, input *UploadFileInput, tracker *progressTracker) error {
 	pool := NewRoutinePool(input.TaskNum, MAX_PART_NUM)
 	var uploadPartError atomic.Value
 	var errFlag int32
 	var abort int32
 	lock := new(sync.Mutex)
 	for _, uploadPart := range ufc.UploadParts {
-		if atomic.LoadInt32(&abort) == 1 {
+		if atomic.LoadInt32(&abort) == 1 || obsClient.context().Err() != nil {
 			break
 		}
 		if uploadPart.IsCompleted {
@@ -375,6 +401,7 @@ func (obsClient ObsClient) uploadPartConcurrent(ufc *UploadCheckpoint, checkpoin
 				SourceFile: input.UploadFile,
 				Offset:     uploadPart.Offset,
 				PartSize:   uploadPart.PartSize,
+				tracker:    tracker,
 			},
 			obsClient:        &obsClient,
 			abort:            &abort,
@@ -386,10 +413,16 @@ func (obsClient ObsClient) uploadPartConcurrent(ufc *UploadCheckpoint, checkpoin
 			if err != nil && atomic.CompareAndSwapInt32(&errFlag, 0, 1) {
 				uploadPartError.Store(err)
 			}
+			if _, ok := result.(*UploadPartOutput); ok {
+				tracker.partCompleted(task.PartNumber)
+			}
 			return nil
 		})
 	}
 	pool.ShutDown()
+	if err := obsClient.context().Err(); err != nil {
+		return err
+	}
 	if err, ok := uploadPartError.Load().(error); ok {
 		return err
 	}
@@ -464,7 +497,7 @@ type downloadPartTask struct {
 }
 
 func (task *downloadPartTask) Run() interface{} {
-	if atomic.LoadInt32(task.abort) == 1 {
+	if atomic.LoadInt32(task.abort) == 1 || task.obsClient.context().Err() != nil {
 		return errAbort
 	}
 	getObjectInput := &GetObjectInput{}
@@ -475,6 +508,7 @@ func (task *downloadPartTask) Run() interface{} {
 	getObjectInput.IfUnmodifiedSince = task.IfUnmodifiedSince
 	getObjectInput.RangeStart = task.RangeStart
 	getObjectInput.RangeEnd = task.RangeEnd
+	getObjectInput.tracker = task.tracker
 
 	var output *GetObjectOutput
 	var err error
@@ -499,6 +533,9 @@ func (task *downloadPartTask) Run() interface{} {
 	} else if obsError, ok := err.(ObsError); ok && obsError.StatusCode >= 400 && obsError.StatusCode < 500 {
 		atomic.CompareAndSwapInt32(task.abort, 0, 1)
 		doLog(LEVEL_WARN, "Task is aborted, part number is [%d]", task.partNumber)
+	} else if task.obsClient.context().Err() != nil {
+		atomic.CompareAndSwapInt32(task.abort, 0, 1)
+		doLog(LEVEL_WARN, "Task is cancelled, part number is [%d]", task.partNumber)
 	}
 	return err
 }
@@ -693,17 +730,30 @@ func (obsClient ObsClient) resumeDownload(input *DownloadFileInput) (output *Get
 		}
 	}
 
-	downloadFileError := obsClient.downloadFileConcurrent(input, dfc)
+	tracker := newProgressTracker(input.ProgressListener, obsClient.getRateLimiter(input.RateLimiter), objectSize)
+	if tracker != nil {
+		for _, downloadPart := range dfc.DownloadParts {
+			if downloadPart.IsCompleted {
+				tracker.consumed += downloadPart.RangeEnd - downloadPart.Offset + 1
+			}
+		}
+	}
+	tracker.started()
+
+	downloadFileError := obsClient.downloadFileConcurrent(input, dfc, tracker)
 	err = handleDownloadFileResult(dfc.TempFileInfo.TempFileUrl, enableCheckpoint, downloadFileError)
 	if err != nil {
+		tracker.finished(err)
 		return nil, err
 	}
 
 	err = os.Rename(dfc.TempFileInfo.TempFileUrl, input.DownloadFile)
 	if err != nil {
 		doLog(LEVEL_ERROR, "Failed to rename temp download file [%s] to download file [%s] with error [%v].", dfc.TempFileInfo.TempFileUrl, input.DownloadFile, err)
+		tracker.finished(err)
 		return nil, err
 	}
+	tracker.finished(nil)
 	if enableCheckpoint {
 		err = os.Remove(checkpointFilePath)
 		if err != nil {
@@ -783,14 +833,14 @@ func handleDownloadTaskResult(result interface{}, dfc *DownloadCheckpoint, partN
 	return
 }
 
-func (obsClient ObsClient) downloadFileConcurrent(input *DownloadFileInput, dfc *DownloadCheckpoint) error {
+func (obsClient ObsClient) downloadFileConcurrent(input *DownloadFileInput, dfc *DownloadCheckpoint, tracker *progressTracker) error {
 	pool := NewRoutinePool(input.TaskNum, MAX_PART_NUM)
 	var downloadPartError atomic.Value
 	var errFlag int32
 	var abort int32
 	lock := new(sync.Mutex)
 	for _, downloadPart := range dfc.DownloadParts {
-		if atomic.LoadInt32(&abort) == 1 {
+		if atomic.LoadInt32(&abort) == 1 || obsClient.context().Err() != nil {
 			break
 		}
 		if downloadPart.IsCompleted {
@@ -805,6 +855,7 @@ func (obsClient ObsClient) downloadFileConcurrent(input *DownloadFileInput, dfc
 				IfModifiedSince:        input.IfModifiedSince,
 				RangeStart:             downloadPart.Offset,
 				RangeEnd:               downloadPart.RangeEnd,
+				tracker:                tracker,
 			},
 			obsClient:        &obsClient,
 			abort:            &abort,
@@ -818,10 +869,16 @@ func (obsClient ObsClient) downloadFileConcurrent(input *DownloadFileInput, dfc
 			if err != nil && atomic.CompareAndSwapInt32(&errFlag, 0, 1) {
 				downloadPartError.Store(err)
 			}
+			if _, ok := result.(*GetObjectOutput); ok {
+				tracker.partCompleted(int(task.partNumber))
+			}
 			return nil
 		})
 	}
 	pool.ShutDown()
+	if err := obsClient.context().Err(); err != nil {
+		return err
+	}
 	if err, ok := downloadPartError.Load().(error); ok {
 		return err
 	}
@@ -0,0 +1,205 @@
+package configurations
+
+import (
+	"sort"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+)
+
+type ChangeType string
+
+const (
+	// ChangeAdded is a parameter present in the target only.
+	ChangeAdded ChangeType = "added"
+	// ChangeRemoved is a parameter present in the source only.
+	ChangeRemoved ChangeType = "removed"
+	// ChangeModified is a parameter with different values.
+	ChangeModified ChangeType = "changed"
+)
+
+type ParameterChange struct {
+	// Parameter name
+	Name string
+	// Type of the change
+	Type ChangeType
+	// Value in the source, empty for added parameters
+	OldValue string
+	// Value in the target, empty for removed parameters
+	NewValue string
+	// Whether changing the parameter requires a reboot
+	RestartRequired bool
+	// Whether the parameter is read-only
+	ReadOnly bool
+}
+
+// ConfigurationDiff lists the parameter changes between two configurations, sorted by the parameter names.
+type ConfigurationDiff struct {
+	Changes []ParameterChange
+}
+
+// Empty returns true if the configurations have the same parameters.
+func (d *ConfigurationDiff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// RestartRequired returns true if applying any of the changes requires a reboot.
+func (d *ConfigurationDiff) RestartRequired() bool {
+	for _, change := range d.Changes {
+		if change.RestartRequired {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare returns the changes turning the parameters of the source configuration into those of the target.
+// The restart_required and readonly metadata are taken from the target, or from the source for removed parameters.
+func Compare(source, target *Configuration) *ConfigurationDiff {
+	sourceParams := make(map[string]Parameter, len(source.Parameters))
+	for _, p := range source.Parameters {
+		sourceParams[p.Name] = p
+	}
+	targetParams := make(map[string]Parameter, len(target.Parameters))
+	for _, p := range target.Parameters {
+		targetParams[p.Name] = p
+	}
+
+	diff := &ConfigurationDiff{}
+	for name, t := range targetParams {
+		s, ok := sourceParams[name]
+		switch {
+		case !ok:
+			diff.Changes = append(diff.Changes, ParameterChange{
+				Name: name, Type: ChangeAdded, NewValue: t.Value, RestartRequired: t.RestartRequired, ReadOnly: t.ReadOnly,
+			})
+		case s.Value != t.Value:
+			diff.Changes = append(diff.Changes, ParameterChange{
+				Name: name, Type: ChangeModified, OldValue: s.Value, NewValue: t.Value, RestartRequired: t.RestartRequired, ReadOnly: t.ReadOnly,
+			})
+		}
+	}
+	for name, s := range sourceParams {
+		if _, ok := targetParams[name]; !ok {
+			diff.Changes = append(diff.Changes, ParameterChange{
+				Name: name, Type: ChangeRemoved, OldValue: s.Value, RestartRequired: s.RestartRequired, ReadOnly: s.ReadOnly,
+			})
+		}
+	}
+	sort.Slice(diff.Changes, func(i, j int) bool { return diff.Changes[i].Name < diff.Changes[j].Name })
+	return diff
+}
+
+// CompareTemplates compares two parameter templates.
+func CompareTemplates(client *golangsdk.ServiceClient, sourceID, targetID string) (*ConfigurationDiff, error) {
+	source, err := Get(client, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := Get(client, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(source, target), nil
+}
+
+// CompareWithInstance compares the effective parameters of the instance with the template. The diff
+// lists the drift of the instance: the changes bringing the instance back to the template.
+func CompareWithInstance(client *golangsdk.ServiceClient, instanceID, configID string) (*ConfigurationDiff, error) {
+	source, err := GetForInstance(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := Get(client, configID)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(source, target), nil
+}
+
+// ApplyPlan applies the changes of a diff to an instance in batches.
+type ApplyPlan struct {
+	// DB instance ID
+	InstanceId string
+	// Batches applied one after another, the batches without restart required first
+	Batches []ApplyBatch
+	// Changes which can't be applied to an instance: removed and read-only parameters
+	Skipped []ParameterChange
+}
+
+type ApplyBatch struct {
+	// Values of the batch passed to UpdateInstanceConfiguration
+	Values map[string]interface{}
+	// Whether the batch requires a reboot to take effect
+	RestartRequired bool
+	// Changes applied by the batch
+	Changes []ParameterChange
+}
+
+// Plan returns the plan applying the added and changed parameters of the diff to the instance.
+// The parameters not requiring a reboot are applied first, each batch contains up to batchSize
+// parameters, all of them in a single batch per restart mode if batchSize is not positive.
+func (d *ConfigurationDiff) Plan(instanceID string, batchSize int) *ApplyPlan {
+	plan := &ApplyPlan{InstanceId: instanceID}
+	var online, restart []ParameterChange
+	for _, change := range d.Changes {
+		switch {
+		case change.Type == ChangeRemoved || change.ReadOnly:
+			plan.Skipped = append(plan.Skipped, change)
+		case change.RestartRequired:
+			restart = append(restart, change)
+		default:
+			online = append(online, change)
+		}
+	}
+	plan.Batches = append(plan.Batches, batches(online, false, batchSize)...)
+	plan.Batches = append(plan.Batches, batches(restart, true, batchSize)...)
+	return plan
+}
+
+func batches(changes []ParameterChange, restartRequired bool, batchSize int) []ApplyBatch {
+	if batchSize <= 0 {
+		batchSize = len(changes)
+	}
+	var result []ApplyBatch
+	for start := 0; start < len(changes); start += batchSize {
+		end := start + batchSize
+		if end > len(changes) {
+			end = len(changes)
+		}
+		batch := ApplyBatch{
+			Values:          make(map[string]interface{}, end-start),
+			RestartRequired: restartRequired,
+			Changes:         changes[start:end],
+		}
+		for _, change := range batch.Changes {
+			batch.Values[change.Name] = change.NewValue
+		}
+		result = append(result, batch)
+	}
+	return result
+}
+
+// Execute applies the batches of the plan with UpdateInstanceConfiguration. If timeout is positive,
+// it waits up to timeout seconds for the job of each batch to complete before applying the next one.
+// The responses of the applied batches are returned, also if a batch fails.
+func (p *ApplyPlan) Execute(client *golangsdk.ServiceClient, timeout int) ([]UpdateInstanceConfigurationResponse, error) {
+	var responses []UpdateInstanceConfigurationResponse
+	for _, batch := range p.Batches {
+		res, err := UpdateInstanceConfiguration(client, UpdateInstanceConfigurationOpts{
+			InstanceId: p.InstanceId,
+			Values:     batch.Values,
+		})
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, *res)
+
+		if timeout > 0 && res.JobId != "" {
+			if err := instances.WaitForJobCompleted(client, timeout, res.JobId); err != nil {
+				return responses, err
+			}
+		}
+	}
+	return responses, nil
+}
@@ -0,0 +1,123 @@
+package testing
+
+const templateOutput = `
+{
+    "id": "%s",
+    "name": "%s",
+    "datastore_version_name": "8.0",
+    "datastore_name": "mysql",
+    "description": "",
+    "created": "2023-06-01T08:00:00+0000",
+    "updated": "2023-06-01T08:00:00+0000",
+    "user_defined": true,
+    "configuration_parameters": [
+        {
+            "name": "max_connections",
+            "value": "%s",
+            "restart_required": false,
+            "readonly": false,
+            "value_range": "10-100000",
+            "type": "integer",
+            "description": "Maximum number of connections"
+        },
+        {
+            "name": "innodb_buffer_pool_size",
+            "value": "%s",
+            "restart_required": true,
+            "readonly": false,
+            "value_range": "5242880-1099511627776",
+            "type": "integer",
+            "description": "Size of the InnoDB buffer pool"
+        },
+        {
+            "name": "character_set_server",
+            "value": "utf8mb4",
+            "restart_required": false,
+            "readonly": true,
+            "value_range": "utf8,utf8mb4",
+            "type": "list",
+            "description": "Server character set"
+        }
+    ]
+}
+`
+
+const instanceConfigurationOutput = `
+{
+    "datastore_version_name": "8.0",
+    "datastore_name": "mysql",
+    "created": "2023-06-01T08:00:00+0000",
+    "updated": "2023-06-02T08:00:00+0000",
+    "configuration_parameters": [
+        {
+            "name": "max_connections",
+            "value": "500",
+            "restart_required": false,
+            "readonly": false,
+            "value_range": "10-100000",
+            "type": "integer",
+            "description": "Maximum number of connections"
+        },
+        {
+            "name": "innodb_buffer_pool_size",
+            "value": "134217728",
+            "restart_required": true,
+            "readonly": false,
+            "value_range": "5242880-1099511627776",
+            "type": "integer",
+            "description": "Size of the InnoDB buffer pool"
+        },
+        {
+            "name": "character_set_server",
+            "value": "utf8",
+            "restart_required": false,
+            "readonly": true,
+            "value_range": "utf8,utf8mb4",
+            "type": "list",
+            "description": "Server character set"
+        },
+        {
+            "name": "slow_query_log",
+            "value": "ON",
+            "restart_required": false,
+            "readonly": false,
+            "value_range": "ON,OFF",
+            "type": "boolean",
+            "description": "Enables the slow query log"
+        }
+    ]
+}
+`
+
+const updateRequest = `
+{
+    "values": {
+        "%s": "%s"
+    }
+}
+`
+
+const updateOutput = `
+{
+    "restart_required": %t,
+    "job_id": "%s",
+    "ignored_params": []
+}
+`
+
+const jobOutput = `
+{
+    "job": {
+        "id": "%s",
+        "name": "UpdateInstanceConfiguration",
+        "status": "Completed",
+        "created": "2023-06-02T08:00:00+0000",
+        "process": "",
+        "instance": {
+            "id": "instance-id",
+            "name": "mysql-1"
+        },
+        "entities": {}
+    }
+}
+`
@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func handleTemplate(t *testing.T, id, maxConnections, bufferPoolSize string) {
+	th.Mux.HandleFunc("/configurations/"+id, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, templateOutput, id, id, maxConnections, bufferPoolSize)
+	})
+}
+
+func TestCompareTemplates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleTemplate(t, "source-id", "500", "134217728")
+	handleTemplate(t, "target-id", "1000", "268435456")
+
+	diff, err := configurations.CompareTemplates(fake.ServiceClient(), "source-id", "target-id")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []configurations.ParameterChange{
+		{Name: "innodb_buffer_pool_size", Type: configurations.ChangeModified, OldValue: "134217728", NewValue: "268435456", RestartRequired: true},
+		{Name: "max_connections", Type: configurations.ChangeModified, OldValue: "500", NewValue: "1000"},
+	}, diff.Changes)
+	th.AssertEquals(t, true, diff.RestartRequired())
+
+	same, err := configurations.CompareTemplates(fake.ServiceClient(), "source-id", "source-id")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, same.Empty())
+}
+
+func TestCompareWithInstance(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleTemplate(t, "config-id", "1000", "134217728")
+	th.Mux.HandleFunc("/instances/instance-id/configurations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, instanceConfigurationOutput)
+	})
+
+	diff, err := configurations.CompareWithInstance(fake.ServiceClient(), "instance-id", "config-id")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []configurations.ParameterChange{
+		{Name: "character_set_server", Type: configurations.ChangeModified, OldValue: "utf8", NewValue: "utf8mb4", ReadOnly: true},
+		{Name: "max_connections", Type: configurations.ChangeModified, OldValue: "500", NewValue: "1000"},
+		{Name: "slow_query_log", Type: configurations.ChangeRemoved, OldValue: "ON"},
+	}, diff.Changes)
+	th.AssertEquals(t, false, diff.RestartRequired())
+}
+
+func TestPlan(t *testing.T) {
+	diff := &configurations.ConfigurationDiff{Changes: []configurations.ParameterChange{
+		{Name: "a", Type: configurations.ChangeModified, NewValue: "1", RestartRequired: true},
+		{Name: "b", Type: configurations.ChangeAdded, NewValue: "2"},
+		{Name: "c", Type: configurations.ChangeModified, NewValue: "3", ReadOnly: true},
+		{Name: "d", Type: configurations.ChangeRemoved, OldValue: "4"},
+		{Name: "e", Type: configurations.ChangeModified, NewValue: "5"},
+		{Name: "f", Type: configurations.ChangeModified, NewValue: "6"},
+	}}
+
+	plan := diff.Plan("instance-id", 2)
+	th.AssertEquals(t, "instance-id", plan.InstanceId)
+	th.AssertEquals(t, 3, len(plan.Batches))
+	th.AssertDeepEquals(t, map[string]interface{}{"b": "2", "e": "5"}, plan.Batches[0].Values)
+	th.AssertEquals(t, false, plan.Batches[0].RestartRequired)
+	th.AssertDeepEquals(t, map[string]interface{}{"f": "6"}, plan.Batches[1].Values)
+	th.AssertDeepEquals(t, map[string]interface{}{"a": "1"}, plan.Batches[2].Values)
+	th.AssertEquals(t, true, plan.Batches[2].RestartRequired)
+	th.AssertEquals(t, 2, len(plan.Skipped))
+	th.AssertEquals(t, "c", plan.Skipped[0].Name)
+	th.AssertEquals(t, "d", plan.Skipped[1].Name)
+
+	th.AssertEquals(t, 2, len(diff.Plan("instance-id", 0).Batches))
+}
+
+func TestExecutePlan(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var requests int
+	th.Mux.HandleFunc("/instances/instance-id/configurations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		requests++
+		w.Header().Add("Content-Type", "application/json")
+		if requests == 1 {
+			th.TestJSONRequest(t, r, fmt.Sprintf(updateRequest, "max_connections", "1000"))
+			_, _ = fmt.Fprintf(w, updateOutput, false, "job-1")
+			return
+		}
+		th.TestJSONRequest(t, r, fmt.Sprintf(updateRequest, "innodb_buffer_pool_size", "268435456"))
+		_, _ = fmt.Fprintf(w, updateOutput, true, "job-2")
+	})
+	var jobs []string
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		jobs = append(jobs, r.URL.Query().Get("id"))
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, jobOutput, r.URL.Query().Get("id"))
+	})
+
+	diff := &configurations.ConfigurationDiff{Changes: []configurations.ParameterChange{
+		{Name: "innodb_buffer_pool_size", Type: configurations.ChangeModified, NewValue: "268435456", RestartRequired: true},
+		{Name: "max_connections", Type: configurations.ChangeModified, NewValue: "1000"},
+	}}
+	responses, err := diff.Plan("instance-id", 0).Execute(fake.ServiceClient(), 60)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(responses))
+	th.AssertEquals(t, false, responses[0].RestartRequired)
+	th.AssertEquals(t, true, responses[1].RestartRequired)
+	th.AssertDeepEquals(t, []string{"job-1", "job-2"}, jobs)
+}
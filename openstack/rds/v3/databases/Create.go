@@ -0,0 +1,44 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type CreateOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the database name.
+	// MySQL: 1 to 64 characters, letters, digits, hyphens (-), underscores (_) and dollar signs ($).
+	// PostgreSQL: 1 to 63 characters, letters, digits and underscores (_), cannot start with pg or a digit.
+	// Microsoft SQL Server: 1 to 64 characters, letters, digits, hyphens (-) and underscores (_).
+	Name string `json:"name" required:"true"`
+	// Specifies the character set used by the database, such as utf8, gbk, and ascii.
+	// Mandatory for MySQL, optional for PostgreSQL, defaults to UTF8.
+	CharacterSet string `json:"character_set,omitempty"`
+	// Specifies the database owner. PostgreSQL only, defaults to root.
+	Owner string `json:"owner,omitempty"`
+	// Specifies the name of the database template, template0 or template1. PostgreSQL only, defaults to template1.
+	Template string `json:"template,omitempty"`
+	// Specifies the database collation, such as en_US.UTF-8. PostgreSQL only.
+	LcCollate string `json:"lc_collate,omitempty"`
+	// Specifies the database classification, such as en_US.UTF-8. PostgreSQL only.
+	LcCtype string `json:"lc_ctype,omitempty"`
+	// Specifies the database remarks. MySQL only, up to 512 characters.
+	Comment string `json:"comment,omitempty"`
+}
+
+func Create(client *golangsdk.ServiceClient, opts CreateOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/database
+	_, err = client.Post(client.ServiceURL("instances", opts.InstanceId, "database"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
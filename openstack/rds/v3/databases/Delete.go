@@ -0,0 +1,15 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+func Delete(client *golangsdk.ServiceClient, instanceId, dbName string) (err error) {
+	// DELETE https://{Endpoint}/v3/{project_id}/instances/{instance_id}/database/{db_name}
+	_, err = client.Delete(client.ServiceURL("instances", instanceId, "database", dbName), &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
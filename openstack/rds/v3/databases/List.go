@@ -0,0 +1,70 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type ListOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the page number. The value starts from 1.
+	Page int `q:"page" required:"true"`
+	// Specifies the number of records on each page. The value ranges from 1 to 100.
+	Limit int `q:"limit" required:"true"`
+}
+
+func List(client *golangsdk.ServiceClient, opts ListOpts) (*ListResponse, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/database/detail
+	raw, err := client.Get(client.ServiceURL("instances", opts.InstanceId, "database", "detail")+query.String(), nil, openstack.StdRequestOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	// Indicates the database information.
+	Databases []Database `json:"databases"`
+	// Indicates the total number of databases.
+	TotalCount int `json:"total_count"`
+}
+
+type Database struct {
+	// Indicates the database name.
+	Name string `json:"name"`
+	// Indicates the character set used by the database.
+	CharacterSet string `json:"character_set"`
+	// Indicates the database owner. PostgreSQL only.
+	Owner string `json:"owner"`
+	// Indicates the database collation. PostgreSQL only.
+	LcCollate string `json:"lc_collate"`
+	// Indicates the database classification. PostgreSQL only.
+	LcCtype string `json:"lc_ctype"`
+	// Indicates the database remarks. MySQL only.
+	Comment string `json:"comment"`
+}
+
+// ListAll queries all databases of the instance page by page.
+func ListAll(client *golangsdk.ServiceClient, instanceId string) ([]Database, error) {
+	var all []Database
+	for page := 1; ; page++ {
+		res, err := List(client, ListOpts{InstanceId: instanceId, Page: page, Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, res.Databases...)
+		if len(res.Databases) == 0 || len(all) >= res.TotalCount {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type ListAuthorizedUsersOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the database name.
+	DbName string `q:"db-name,required"`
+	// Specifies the page number. The value starts from 1.
+	Page int `q:"page,required"`
+	// Specifies the number of records on each page. The value ranges from 1 to 100.
+	Limit int `q:"limit,required"`
+}
+
+// ListAuthorizedUsers queries the accounts authorized to access the database. MySQL and Microsoft SQL Server only.
+func ListAuthorizedUsers(client *golangsdk.ServiceClient, opts ListAuthorizedUsersOpts) (*ListAuthorizedUsersResponse, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/database/db_user
+	raw, err := client.Get(client.ServiceURL("instances", opts.InstanceId, "database", "db_user")+query.String(), nil, openstack.StdRequestOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListAuthorizedUsersResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListAuthorizedUsersResponse struct {
+	// Indicates the accounts authorized to access the database.
+	Users []AuthorizedUser `json:"users"`
+	// Indicates the total number of accounts.
+	TotalCount int `json:"total_count"`
+}
+
+type AuthorizedUser struct {
+	// Indicates the account name.
+	Name string `json:"name"`
+	// Indicates whether the account has the read-only permission.
+	Readonly bool `json:"readonly"`
+}
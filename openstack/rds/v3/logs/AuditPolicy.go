@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type SetAuditPolicyOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the number of days for storing audit logs. The value ranges from 0 to 732.
+	// The value 0 indicates that SQL audit is disabled.
+	KeepDays *int `json:"keep_days" required:"true"`
+	// Specifies whether historical audit logs are retained when SQL audit is disabled.
+	// The default value is true. This parameter is valid only when SQL audit is disabled.
+	ReserveAuditlogs *bool `json:"reserve_auditlogs,omitempty"`
+	// Specifies the audited operation types, such as CREATE_USER, INSERT and SELECT.
+	// All operation types are audited if not set. MySQL only.
+	AuditTypes []string `json:"audit_types,omitempty"`
+}
+
+// SetAuditPolicy enables, disables or changes the SQL audit policy of the instance.
+// SQL audit is supported for MySQL and PostgreSQL.
+func SetAuditPolicy(client *golangsdk.ServiceClient, opts SetAuditPolicyOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// PUT https://{Endpoint}/v3/{project_id}/instances/{instance_id}/auditlog-policy
+	_, err = client.Put(client.ServiceURL("instances", opts.InstanceId, "auditlog-policy"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+func GetAuditPolicy(client *golangsdk.ServiceClient, instanceId string) (*AuditPolicy, error) {
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/auditlog-policy
+	raw, err := client.Get(client.ServiceURL("instances", instanceId, "auditlog-policy"), nil, openstack.StdRequestOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	var res AuditPolicy
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type AuditPolicy struct {
+	// Indicates the number of days for storing audit logs. The value 0 indicates that SQL audit is disabled.
+	KeepDays int `json:"keep_days"`
+	// Indicates the audited operation types.
+	AuditTypes []string `json:"audit_types"`
+}
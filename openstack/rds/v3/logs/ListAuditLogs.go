@@ -0,0 +1,99 @@
+package logs
+
+import (
+	"strconv"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type ListAuditLogsOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the start time in the "yyyy-mm-ddThh:mm:ssZ" format.
+	// T is the separator between the calendar and the hourly notation of time. Z indicates the time zone offset.
+	StartTime string `q:"start_time,required"`
+	// Specifies the end time in the "yyyy-mm-ddThh:mm:ssZ" format.
+	// The end time must be later than the start time and the time span cannot be longer than 30 days.
+	EndTime string `q:"end_time,required"`
+	// Specifies the index position. If offset is set to N, the resource query starts from the N+1 piece of data.
+	// It is always sent, including the offset 0 of the first page.
+	Offset int `q:"offset"`
+	// Specifies the number of records on a page. The value ranges from 1 to 50.
+	Limit int `q:"limit,required"`
+}
+
+// ListAuditLogs queries the audit log files of the instance.
+func ListAuditLogs(client *golangsdk.ServiceClient, opts ListAuditLogsOpts) (*ListAuditLogsResp, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	// offset is mandatory, but the query builder skips the zero value
+	params := query.Query()
+	params.Set("offset", strconv.Itoa(opts.Offset))
+	query.RawQuery = params.Encode()
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/auditlog
+	url := client.ServiceURL("instances", opts.InstanceId, "auditlog") + query.String()
+	raw, err := client.Get(url, nil, openstack.StdRequestOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListAuditLogsResp
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListAuditLogsResp struct {
+	// Indicates the audit log files.
+	Auditlogs []AuditLog `json:"auditlogs"`
+	// Indicates the total number of audit log files.
+	TotalRecord int `json:"total_record"`
+}
+
+type AuditLog struct {
+	// Indicates the audit log ID.
+	Id string `json:"id"`
+	// Indicates the audit log file name.
+	Name string `json:"name"`
+	// Indicates the size of the audit log file in KB.
+	Size float64 `json:"size"`
+	// Indicates the start time of the audit log in the "yyyy-mm-ddThh:mm:ssZ" format.
+	BeginTime string `json:"begin_time"`
+	// Indicates the end time of the audit log in the "yyyy-mm-ddThh:mm:ssZ" format.
+	EndTime string `json:"end_time"`
+}
+
+type AuditLogLinksOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the IDs of the audit logs, up to 50.
+	Ids []string `json:"ids" required:"true"`
+}
+
+// GetAuditLogLinks returns the download links of the audit log files. The links are valid for 5 minutes.
+func GetAuditLogLinks(client *golangsdk.ServiceClient, opts AuditLogLinksOpts) ([]string, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/auditlog-links
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "auditlog-links"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Links []string `json:"links"`
+	}
+	err = extract.Into(raw.Body, &res)
+	return res.Links, err
+}
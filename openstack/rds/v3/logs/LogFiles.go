@@ -0,0 +1,99 @@
+package logs
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type LogFileLinksOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the name of the log file. The links of all log files are returned if not set.
+	FileName string `json:"file_name,omitempty"`
+}
+
+// GetSlowLogLinks returns the download links of the slow query log files of the instance.
+// The links are generated asynchronously: files with the status other than FINISH don't have
+// a link yet and the request should be repeated.
+func GetSlowLogLinks(client *golangsdk.ServiceClient, opts LogFileLinksOpts) (*LogFileLinksResp, error) {
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/slowlog-download
+	return getLogFileLinks(client, "slowlog-download", opts)
+}
+
+// GetErrorLogLinks returns the download links of the error log files of the instance,
+// see GetSlowLogLinks.
+func GetErrorLogLinks(client *golangsdk.ServiceClient, opts LogFileLinksOpts) (*LogFileLinksResp, error) {
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/errorlog-download
+	return getLogFileLinks(client, "errorlog-download", opts)
+}
+
+func getLogFileLinks(client *golangsdk.ServiceClient, path string, opts LogFileLinksOpts) (*LogFileLinksResp, error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, path), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res LogFileLinksResp
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type LogFileLinksResp struct {
+	// Indicates the log files.
+	List []LogFile `json:"list"`
+	// Indicates the status of the link generation.
+	Status string `json:"status"`
+	// Indicates the number of log files.
+	Count int `json:"count"`
+}
+
+type LogFile struct {
+	// Indicates the ID of the link generation task.
+	WorkflowId string `json:"workflow_id"`
+	// Indicates the log file name.
+	FileName string `json:"file_name"`
+	// Indicates the link generation status. Value: FINISH, CREATING or FAILED.
+	Status string `json:"status"`
+	// Indicates the file size in KB.
+	FileSize string `json:"file_size"`
+	// Indicates the download link.
+	FileLink string `json:"file_link"`
+	// Indicates the creation time.
+	CreateAt float64 `json:"create_at"`
+	// Indicates the update time.
+	UpdateAt float64 `json:"update_at"`
+}
+
+// DownloadLogFile streams the log file from the download link returned by GetAuditLogLinks,
+// GetSlowLogLinks or GetErrorLogLinks to w. The links are pre-signed, so no token is sent.
+// It returns the number of bytes written.
+func DownloadLogFile(client *golangsdk.ServiceClient, link string, w io.Writer) (int64, error) {
+	req, err := http.NewRequest("GET", link, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("error downloading log file: unexpected status %s", resp.Status)
+	}
+	return io.Copy(w, resp.Body)
+}
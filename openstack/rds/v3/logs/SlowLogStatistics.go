@@ -0,0 +1,287 @@
+package logs
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type SlowLogStatisticsOpts struct {
+	// Specifies the ID of the queried DB instance.
+	InstanceId string
+	// Specifies the start date in the "yyyy-mm-ddThh:mm:ssZ" format.
+	StartDate string
+	// Specifies the end date in the "yyyy-mm-ddThh:mm:ssZ" format. You can only query slow logs generated within a month.
+	EndDate string
+	// Specifies the statement type, such as SELECT or INSERT. All statement types are queried if not set.
+	Type string
+}
+
+// SlowLogStatistic aggregates the slow queries with the same SQL template.
+type SlowLogStatistic struct {
+	// SQL template, the query with literals replaced by ?, see NormalizeSQL
+	Template string
+	// Statement type
+	Type string
+	// The first query of the template
+	Sample string
+	// Number of executions
+	Count int
+	// Total execution time
+	TotalTime time.Duration
+	// Longest execution time of a single query log record
+	MaxTime time.Duration
+	// Total lock wait time
+	LockTime time.Duration
+	// Total number of sent rows
+	RowsSent int64
+	// Total number of scanned rows
+	RowsExamined int64
+	// Databases the queries were executed in
+	Databases []string
+	// Accounts executing the queries
+	Users []string
+}
+
+// AvgTime returns the average execution time of the queries.
+func (s SlowLogStatistic) AvgTime() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.TotalTime / time.Duration(s.Count)
+}
+
+// SlowLogStatistics returns the slow queries of the period grouped by their SQL templates, the
+// templates with the longest total execution time first.
+//
+// The statistics are read from the slow log statistics API. If it isn't available, all slow log
+// records of the period are read with ListSlowLog and aggregated on the client side.
+func SlowLogStatistics(client *golangsdk.ServiceClient, opts SlowLogStatisticsOpts) ([]SlowLogStatistic, error) {
+	rows, err := listSlowLogStatistics(client, opts)
+	if _, ok := err.(golangsdk.ErrDefault404); ok {
+		rows, err = listAllSlowLogs(client, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return AggregateSlowLogs(rows), nil
+}
+
+type slowLogStatisticsRequest struct {
+	StartDate string `json:"start_date" required:"true"`
+	EndDate   string `json:"end_date" required:"true"`
+	Type      string `json:"type,omitempty"`
+	CurPage   int    `json:"cur_page"`
+	PerPage   int    `json:"per_page"`
+}
+
+func listSlowLogStatistics(client *golangsdk.ServiceClient, opts SlowLogStatisticsOpts) ([]Slowloglist, error) {
+	var all []Slowloglist
+	for page := 1; ; page++ {
+		b, err := build.RequestBody(slowLogStatisticsRequest{
+			StartDate: opts.StartDate,
+			EndDate:   opts.EndDate,
+			Type:      opts.Type,
+			CurPage:   page,
+			PerPage:   100,
+		}, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/slowlog/statistics
+		raw, err := client.Post(client.ServiceURL("instances", opts.InstanceId, "slowlog", "statistics"), b, nil, &golangsdk.RequestOpts{
+			OkCodes:     []int{200},
+			MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var res SlowLogResp
+		if err := extract.Into(raw.Body, &res); err != nil {
+			return nil, err
+		}
+		all = append(all, res.Slowloglist...)
+		if len(res.Slowloglist) == 0 || len(all) >= res.TotalRecord {
+			return all, nil
+		}
+	}
+}
+
+func listAllSlowLogs(client *golangsdk.ServiceClient, opts SlowLogStatisticsOpts) ([]Slowloglist, error) {
+	var all []Slowloglist
+	for page := 1; ; page++ {
+		res, err := ListSlowLog(client, DbSlowLogOpts{
+			InstanceId: opts.InstanceId,
+			StartDate:  opts.StartDate,
+			EndDate:    opts.EndDate,
+			Offset:     strconv.Itoa(page),
+			Limit:      "100",
+			Level:      opts.Type,
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, res.Slowloglist...)
+		if len(res.Slowloglist) == 0 || len(all) >= res.TotalRecord {
+			return all, nil
+		}
+	}
+}
+
+// AggregateSlowLogs groups the slow log records by their SQL templates, the templates with the
+// longest total execution time first.
+func AggregateSlowLogs(rows []Slowloglist) []SlowLogStatistic {
+	byTemplate := make(map[string]*SlowLogStatistic)
+	var order []string
+	for _, row := range rows {
+		template := NormalizeSQL(row.QuerySample)
+		stat, ok := byTemplate[template]
+		if !ok {
+			stat = &SlowLogStatistic{Template: template, Type: row.Type, Sample: row.QuerySample}
+			byTemplate[template] = stat
+			order = append(order, template)
+		}
+
+		count := parseCount(row.Count)
+		queryTime := parseLogDuration(row.Time)
+		stat.Count += count
+		stat.TotalTime += queryTime
+		if queryTime > stat.MaxTime {
+			stat.MaxTime = queryTime
+		}
+		stat.LockTime += parseLogDuration(row.LockTime)
+		stat.RowsSent += parseRows(row.RowsSent)
+		stat.RowsExamined += parseRows(row.RowsExamined)
+		stat.Databases = appendUnique(stat.Databases, row.Database)
+		stat.Users = appendUnique(stat.Users, row.Users)
+	}
+
+	result := make([]SlowLogStatistic, len(order))
+	for i, template := range order {
+		result[i] = *byTemplate[template]
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].TotalTime > result[j].TotalTime })
+	return result
+}
+
+var (
+	inListRegexp      = regexp.MustCompile(`\(\s*\?(\s*,\s*\?)*\s*\)`)
+	repeatedTuplesRe  = regexp.MustCompile(`\(\.\.\.\)(\s*,\s*\(\.\.\.\))+`)
+	trailingSemicolon = regexp.MustCompile(`[\s;]+$`)
+)
+
+// NormalizeSQL returns the SQL template of the query: comments are removed, string and numeric
+// literals are replaced by ?, lists of literals by (...), whitespace is collapsed and the query
+// is lowercased.
+func NormalizeSQL(query string) string {
+	runes := []rune(query)
+	result := make([]rune, 0, len(runes))
+	space := func() {
+		if len(result) > 0 && result[len(result)-1] != ' ' {
+			result = append(result, ' ')
+		}
+	}
+	isIdent := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"':
+			i = skipQuoted(runes, i)
+			result = append(result, '?')
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			for i += 3; i < len(runes) && !(runes[i-1] == '*' && runes[i] == '/'); i++ {
+			}
+			space()
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-', r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			space()
+		case unicode.IsDigit(r) && (len(result) == 0 || !isIdent(result[len(result)-1])):
+			for i+1 < len(runes) && (isIdent(runes[i+1]) || runes[i+1] == '.') {
+				i++
+			}
+			result = append(result, '?')
+		case unicode.IsSpace(r):
+			space()
+		default:
+			result = append(result, unicode.ToLower(r))
+		}
+	}
+
+	normalized := inListRegexp.ReplaceAllString(string(result), "(...)")
+	normalized = repeatedTuplesRe.ReplaceAllString(normalized, "(...)")
+	return strings.TrimSpace(trailingSemicolon.ReplaceAllString(normalized, ""))
+}
+
+// skipQuoted returns the index of the quote closing the literal starting at i.
+func skipQuoted(runes []rune, i int) int {
+	quote := runes[i]
+	for i++; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\':
+			i++
+		case runes[i] == quote && i+1 < len(runes) && runes[i+1] == quote:
+			i++
+		case runes[i] == quote:
+			return i
+		}
+	}
+	return i
+}
+
+// parseLogDuration parses durations of the slow log, e.g. "1.04899 s". Values without a unit are seconds.
+func parseLogDuration(value string) time.Duration {
+	value = strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	if value == "" {
+		return 0
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return d
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return 0
+}
+
+// parseCount parses the number of executions, counting unparsable values as a single execution.
+func parseCount(value string) int {
+	fields := strings.Fields(value)
+	if len(fields) > 0 {
+		if count, err := strconv.Atoi(fields[0]); err == nil {
+			return count
+		}
+	}
+	return 1
+}
+
+func parseRows(value string) int64 {
+	rows, _ := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	return rows
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,63 @@
+package testing
+
+const slowLogOutput = `
+{
+    "slow_log_list": [
+        {
+            "count": "1",
+            "time": "2.5 s",
+            "lock_time": "0.001 s",
+            "rows_sent": "1",
+            "rows_examined": "1000",
+            "database": "shop",
+            "users": "app",
+            "query_sample": "SELECT * FROM orders WHERE id = 42;",
+            "type": "SELECT",
+            "start_time": "2023-06-01T08:00:00Z",
+            "client_ip": "192.168.0.10"
+        },
+        {
+            "count": "2",
+            "time": "3 s",
+            "lock_time": "0 s",
+            "rows_sent": "2",
+            "rows_examined": "2000",
+            "database": "shop",
+            "users": "report",
+            "query_sample": "select *\n  from orders where id = 7",
+            "type": "SELECT",
+            "start_time": "2023-06-01T08:05:00Z",
+            "client_ip": "192.168.0.11"
+        },
+        {
+            "count": "1",
+            "time": "1 s",
+            "lock_time": "0.5 s",
+            "rows_sent": "0",
+            "rows_examined": "0",
+            "database": "shop",
+            "users": "app",
+            "query_sample": "INSERT INTO orders (id, name) VALUES (1, 'a'), (2, 'b')",
+            "type": "INSERT",
+            "start_time": "2023-06-01T08:10:00Z",
+            "client_ip": "192.168.0.10"
+        }
+    ],
+    "total_record": 3
+}
+`
+
+const auditLogsOutput = `
+{
+    "auditlogs": [
+        {
+            "id": "HX_d3lyEZ4PBSAqEq9x0x",
+            "name": "audit_log_2023_06_01_08_00_00.log",
+            "size": 10240.5,
+            "begin_time": "2023-06-01T08:00:00",
+            "end_time": "2023-06-01T09:00:00"
+        }
+    ],
+    "total_record": 1
+}
+`
@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/logs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	cases := map[string]string{
+		"SELECT * FROM t WHERE id = 42;":                        "select * from t where id = ?",
+		"select  *\n from t where name = 'it''s' and x='\\''":   "select * from t where name = ? and x=?",
+		"SELECT * FROM t2 WHERE id IN (1, 2,3) /* hint */ -- c": "select * from t2 where id in (...)",
+		"insert into t values (1, 'a'), (2, 'b')":               "insert into t values (...)",
+		"select col1, 1.5e3 from t":                             "select col1, ? from t",
+	}
+	for query, expected := range cases {
+		th.AssertEquals(t, expected, logs.NormalizeSQL(query))
+	}
+}
+
+func TestSlowLogStatisticsFallback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/slowlog/statistics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	th.Mux.HandleFunc("/instances/instance-id/slowlog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"start_date": "2023-06-01T00:00:00+0000",
+			"end_date":   "2023-06-02T00:00:00+0000",
+			"offset":     "1",
+			"limit":      "100",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, slowLogOutput)
+	})
+
+	stats, err := logs.SlowLogStatistics(fake.ServiceClient(), logs.SlowLogStatisticsOpts{
+		InstanceId: "instance-id",
+		StartDate:  "2023-06-01T00:00:00+0000",
+		EndDate:    "2023-06-02T00:00:00+0000",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(stats))
+
+	th.AssertEquals(t, "select * from orders where id = ?", stats[0].Template)
+	th.AssertEquals(t, 3, stats[0].Count)
+	th.AssertEquals(t, 5500*time.Millisecond, stats[0].TotalTime)
+	th.AssertEquals(t, 3*time.Second, stats[0].MaxTime)
+	th.AssertEquals(t, int64(3000), stats[0].RowsExamined)
+	th.AssertDeepEquals(t, []string{"app", "report"}, stats[0].Users)
+	th.AssertEquals(t, "SELECT * FROM orders WHERE id = 42;", stats[0].Sample)
+
+	th.AssertEquals(t, "insert into orders (id, name) values (...)", stats[1].Template)
+	th.AssertEquals(t, 500*time.Millisecond, stats[1].LockTime)
+}
+
+func TestDownloadLogFile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/download/slow.log", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.AssertEquals(t, "", r.Header.Get("X-Auth-Token"))
+		_, _ = fmt.Fprint(w, "# Time: 2023-06-01T08:00:00Z\nSELECT 1;\n")
+	})
+
+	var buf bytes.Buffer
+	n, err := logs.DownloadLogFile(fake.ServiceClient(), th.Endpoint()+"download/slow.log", &buf)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(buf.Len()), n)
+	th.AssertEquals(t, "# Time: 2023-06-01T08:00:00Z\nSELECT 1;\n", buf.String())
+
+	_, err = logs.DownloadLogFile(fake.ServiceClient(), th.Endpoint()+"download/missing.log", &buf)
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestListAuditLogs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-id/auditlog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"start_time": "2023-06-01T00:00:00+0000",
+			"end_time":   "2023-06-02T00:00:00+0000",
+			"offset":     "0",
+			"limit":      "10",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, auditLogsOutput)
+	})
+
+	opts := logs.ListAuditLogsOpts{
+		InstanceId: "instance-id",
+		StartTime:  "2023-06-01T00:00:00+0000",
+		EndTime:    "2023-06-02T00:00:00+0000",
+		Limit:      10,
+	}
+	res, err := logs.ListAuditLogs(fake.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, res.TotalRecord)
+	th.AssertEquals(t, "HX_d3lyEZ4PBSAqEq9x0x", res.Auditlogs[0].Id)
+	th.AssertEquals(t, 10240.5, res.Auditlogs[0].Size)
+
+	opts.StartTime = ""
+	_, err = logs.ListAuditLogs(fake.ServiceClient(), opts)
+	th.AssertEquals(t, true, err != nil)
+}
@@ -0,0 +1,40 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type CreateOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the username of the database account.
+	// MySQL 5.6: 1 to 16 characters, MySQL 5.7 and 8.0: 1 to 32 characters,
+	// PostgreSQL: 1 to 63 characters, Microsoft SQL Server: 1 to 128 characters.
+	// The username can contain letters, digits, hyphens (-) and underscores (_) and cannot be a system account name.
+	Name string `json:"name" required:"true"`
+	// Specifies the password of the database account.
+	// The value must be 8 to 32 characters long and contain at least three types of the following
+	// characters: uppercase letters, lowercase letters, digits, and special characters ~!@#%^*-_=+?,
+	// The value cannot be the same as the username or the username spelled backwards.
+	Password string `json:"password" required:"true"`
+	// Specifies the IP addresses allowed to access the instance. MySQL only, defaults to %.
+	Hosts []string `json:"hosts,omitempty"`
+	// Specifies the account remarks. MySQL only, up to 512 characters.
+	Comment string `json:"comment,omitempty"`
+}
+
+func Create(client *golangsdk.ServiceClient, opts CreateOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_user
+	_, err = client.Post(client.ServiceURL("instances", opts.InstanceId, "db_user"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
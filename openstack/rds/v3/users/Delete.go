@@ -0,0 +1,15 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+func Delete(client *golangsdk.ServiceClient, instanceId, userName string) (err error) {
+	// DELETE https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_user/{user_name}
+	_, err = client.Delete(client.ServiceURL("instances", instanceId, "db_user", userName), &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
@@ -0,0 +1,70 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type GrantOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the database name.
+	DbName string `json:"db_name" required:"true"`
+	// Specifies the accounts to be authorized, up to 50.
+	Users []GrantUser `json:"users" required:"true"`
+}
+
+type GrantUser struct {
+	// Specifies the username of the database account.
+	Name string `json:"name" required:"true"`
+	// Specifies whether the account has the read-only permission.
+	// true: read-only permission, false: read and write permission.
+	Readonly bool `json:"readonly"`
+	// Specifies the schema name. PostgreSQL only, mandatory for PostgreSQL.
+	SchemaName string `json:"schema_name,omitempty"`
+}
+
+// Grant authorizes the accounts to access the database.
+func Grant(client *golangsdk.ServiceClient, opts GrantOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_privilege
+	_, err = client.Post(client.ServiceURL("instances", opts.InstanceId, "db_privilege"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+type RevokeOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the database name.
+	DbName string `json:"db_name" required:"true"`
+	// Specifies the accounts whose permissions are revoked, up to 50.
+	Users []RevokeUser `json:"users" required:"true"`
+}
+
+type RevokeUser struct {
+	// Specifies the username of the database account.
+	Name string `json:"name" required:"true"`
+}
+
+// Revoke revokes the permissions of the accounts to access the database. MySQL and Microsoft SQL Server only.
+func Revoke(client *golangsdk.ServiceClient, opts RevokeOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// DELETE https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_privilege
+	_, err = client.DeleteWithBody(client.ServiceURL("instances", opts.InstanceId, "db_privilege"), b, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
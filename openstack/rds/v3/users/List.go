@@ -0,0 +1,87 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type ListOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the page number. The value starts from 1.
+	Page int `q:"page" required:"true"`
+	// Specifies the number of records on each page. The value ranges from 1 to 100.
+	Limit int `q:"limit" required:"true"`
+}
+
+func List(client *golangsdk.ServiceClient, opts ListOpts) (*ListResponse, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_user/detail
+	raw, err := client.Get(client.ServiceURL("instances", opts.InstanceId, "db_user", "detail")+query.String(), nil, openstack.StdRequestOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListResponse struct {
+	// Indicates the database accounts.
+	Users []User `json:"users"`
+	// Indicates the total number of database accounts.
+	TotalCount int `json:"total_count"`
+}
+
+type User struct {
+	// Indicates the username of the database account.
+	Name string `json:"name"`
+	// Indicates the databases the account is authorized to access. MySQL and Microsoft SQL Server only.
+	Databases []AuthorizedDatabase `json:"databases"`
+	// Indicates the IP addresses allowed to access the instance. MySQL only.
+	Hosts []string `json:"hosts"`
+	// Indicates the account remarks. MySQL only.
+	Comment string `json:"comment"`
+	// Indicates the attributes of the account. PostgreSQL only.
+	Attributes *UserAttributes `json:"attributes"`
+}
+
+type UserAttributes struct {
+	// Indicates whether the account is a superuser.
+	RolSuper bool `json:"rolsuper"`
+	// Indicates whether the account inherits the permissions of its roles.
+	RolInherit bool `json:"rolinherit"`
+	// Indicates whether the account can create roles.
+	RolCreateRole bool `json:"rolcreaterole"`
+	// Indicates whether the account can create databases.
+	RolCreateDb bool `json:"rolcreatedb"`
+	// Indicates whether the account can log in.
+	RolCanLogin bool `json:"rolcanlogin"`
+	// Indicates the maximum number of concurrent connections of the account, -1 for no limit.
+	RolConnLimit int `json:"rolconnlimit"`
+	// Indicates whether the account is a replication role.
+	RolReplication bool `json:"rolreplication"`
+	// Indicates whether the account bypasses row level security policies.
+	RolBypassRls bool `json:"rolbypassrls"`
+}
+
+// ListAll queries all database accounts of the instance page by page.
+func ListAll(client *golangsdk.ServiceClient, instanceId string) ([]User, error) {
+	var all []User
+	for page := 1; ; page++ {
+		res, err := List(client, ListOpts{InstanceId: instanceId, Page: page, Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, res.Users...)
+		if len(res.Users) == 0 || len(all) >= res.TotalCount {
+			return all, nil
+		}
+	}
+}
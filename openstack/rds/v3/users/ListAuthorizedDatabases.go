@@ -0,0 +1,50 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/extract"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type ListAuthorizedDatabasesOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-"`
+	// Specifies the username of the database account.
+	UserName string `q:"user-name,required"`
+	// Specifies the page number. The value starts from 1.
+	Page int `q:"page,required"`
+	// Specifies the number of records on each page. The value ranges from 1 to 100.
+	Limit int `q:"limit,required"`
+}
+
+// ListAuthorizedDatabases queries the databases the account is authorized to access. MySQL and Microsoft SQL Server only.
+func ListAuthorizedDatabases(client *golangsdk.ServiceClient, opts ListAuthorizedDatabasesOpts) (*ListAuthorizedDatabasesResponse, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// GET https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_user/database
+	raw, err := client.Get(client.ServiceURL("instances", opts.InstanceId, "db_user", "database")+query.String(), nil, openstack.StdRequestOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	var res ListAuthorizedDatabasesResponse
+	err = extract.Into(raw.Body, &res)
+	return &res, err
+}
+
+type ListAuthorizedDatabasesResponse struct {
+	// Indicates the databases the account is authorized to access.
+	Databases []AuthorizedDatabase `json:"databases"`
+	// Indicates the total number of databases.
+	TotalCount int `json:"total_count"`
+}
+
+type AuthorizedDatabase struct {
+	// Indicates the database name.
+	Name string `json:"name"`
+	// Indicates whether the account has the read-only permission.
+	Readonly bool `json:"readonly"`
+}
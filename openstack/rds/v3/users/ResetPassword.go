@@ -0,0 +1,53 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/internal/build"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+type ResetPasswordOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the username of the database account.
+	Name string `json:"name" required:"true"`
+	// Specifies the new password of the database account, see CreateOpts.Password.
+	Password string `json:"password" required:"true"`
+}
+
+// ResetPassword resets the password of a database account.
+func ResetPassword(client *golangsdk.ServiceClient, opts ResetPasswordOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/db_user/resetpwd
+	_, err = client.Post(client.ServiceURL("instances", opts.InstanceId, "db_user", "resetpwd"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+type ResetRootPasswordOpts struct {
+	// Specifies the DB instance ID.
+	InstanceId string `json:"-" required:"true"`
+	// Specifies the new password of the root account, see CreateOpts.Password.
+	DbUserPwd string `json:"db_user_pwd" required:"true"`
+}
+
+// ResetRootPassword resets the password of the administrator account of the instance.
+func ResetRootPassword(client *golangsdk.ServiceClient, opts ResetRootPasswordOpts) (err error) {
+	b, err := build.RequestBody(opts, "")
+	if err != nil {
+		return
+	}
+
+	// POST https://{Endpoint}/v3/{project_id}/instances/{instance_id}/password
+	_, err = client.Post(client.ServiceURL("instances", opts.InstanceId, "password"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}